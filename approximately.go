@@ -0,0 +1,108 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// Approximately returns a matcher that matches values within epsilon of
+// expected. The arguments must either both be float64 values or both be
+// time.Duration values, and candidates must be of the same type.
+//
+// For example:
+//
+//     ExpectThat(elapsed, Approximately(time.Second, 10*time.Millisecond))
+//     ExpectThat(ratio, Approximately(0.5, 1e-9))
+//
+func Approximately(expected, epsilon interface{}) oglematchers.Matcher {
+	switch e := expected.(type) {
+	case float64:
+		if eps, ok := epsilon.(float64); ok {
+			return &approximatelyMatcher{expected: e, epsilon: eps}
+		}
+
+	case time.Duration:
+		if eps, ok := epsilon.(time.Duration); ok {
+			return &approximatelyMatcher{
+				expected:   float64(e),
+				epsilon:    float64(eps),
+				isDuration: true,
+			}
+		}
+
+	default:
+		panic(fmt.Sprintf("Approximately: unsupported type %T", expected))
+	}
+
+	panic(fmt.Sprintf(
+		"Approximately: epsilon type %T doesn't match expected type %T",
+		epsilon,
+		expected))
+}
+
+type approximatelyMatcher struct {
+	expected   float64
+	epsilon    float64
+	isDuration bool
+}
+
+// Format the supplied value using the units appropriate to the matcher.
+func (m *approximatelyMatcher) format(x float64) string {
+	if m.isDuration {
+		return time.Duration(x).String()
+	}
+
+	return fmt.Sprintf("%v", x)
+}
+
+func (m *approximatelyMatcher) Description() string {
+	return fmt.Sprintf(
+		"within %s of %s",
+		m.format(m.epsilon),
+		m.format(m.expected))
+}
+
+func (m *approximatelyMatcher) Matches(c interface{}) error {
+	var actual float64
+	if m.isDuration {
+		d, ok := c.(time.Duration)
+		if !ok {
+			return oglematchers.NewFatalError("which is not a time.Duration")
+		}
+
+		actual = float64(d)
+	} else {
+		f, ok := c.(float64)
+		if !ok {
+			return oglematchers.NewFatalError("which is not a float64")
+		}
+
+		actual = f
+	}
+
+	diff := math.Abs(actual - m.expected)
+	if diff <= m.epsilon {
+		return nil
+	}
+
+	return errors.New(fmt.Sprintf("which differs by %s", m.format(diff)))
+}
@@ -0,0 +1,64 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+func TestApproximatelyFloat(t *testing.T) {
+	m := Approximately(1.0, 0.25)
+	expectEqStr(t, "within 0.25 of 1", m.Description())
+
+	if err := m.Matches(1.25); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	err := m.Matches(1.5)
+	if err == nil {
+		t.Fatalf("Expected an error.")
+	}
+
+	expectEqStr(t, "which differs by 0.5", err.Error())
+}
+
+func TestApproximatelyDuration(t *testing.T) {
+	m := Approximately(time.Second, 10*time.Millisecond)
+	expectEqStr(t, "within 10ms of 1s", m.Description())
+
+	if err := m.Matches(995 * time.Millisecond); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	err := m.Matches(1500 * time.Millisecond)
+	if err == nil {
+		t.Fatalf("Expected an error.")
+	}
+
+	expectEqStr(t, "which differs by 500ms", err.Error())
+}
+
+func TestApproximatelyWrongCandidateType(t *testing.T) {
+	m := Approximately(time.Second, time.Millisecond)
+
+	err := m.Matches(1.0)
+	if _, ok := err.(*oglematchers.FatalError); !ok {
+		t.Errorf("Expected a fatal error, got %v", err)
+	}
+}
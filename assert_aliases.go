@@ -20,20 +20,23 @@ import (
 )
 
 // AssertEq(e, a) is equivalent to AssertThat(a, oglematchers.Equals(e)).
+// If the type of e has a method Equal(T) bool, as time.Time does, it is used
+// instead.
 func AssertEq(expected, actual interface{}, errorParts ...interface{}) {
 	assertThat(
 		actual,
-		oglematchers.Equals(expected),
+		equals(expected),
 		1,
 		errorParts)
 }
 
 // AssertNe(e, a) is equivalent to
 // AssertThat(a, oglematchers.Not(oglematchers.Equals(e))).
+// As with AssertEq, an Equal method on the type of e is used if present.
 func AssertNe(expected, actual interface{}, errorParts ...interface{}) {
 	assertThat(
 		actual,
-		oglematchers.Not(oglematchers.Equals(expected)),
+		oglematchers.Not(equals(expected)),
 		1,
 		errorParts)
 }
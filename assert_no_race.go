@@ -0,0 +1,98 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// AssertNoRace calls fn concurrently from runtime.NumCPU() goroutines, started
+// as close to simultaneously as possible, and waits for them all to return.
+// If any of them panics, a failure is recorded for each panic and the test is
+// halted. The test is likewise halted if fn fails an assertion such as
+// AssertEq.
+//
+// This is a stress test for obvious races, such as unsynchronized map writes,
+// and not a replacement for the race detector; use it together with
+// `go test -race` for best effect.
+//
+// For example:
+//
+//     AssertNoRace(func() { cache.Put("taco", 17) })
+//
+func AssertNoRace(fn func()) {
+	if !expectNoRace(fn, runtime.NumCPU(), 1) {
+		AbortTest()
+	}
+}
+
+// The generalized form of AssertNoRace. depth is the distance on the stack
+// between the caller's frame and the user's frame. Returns passed iff no
+// goroutine panicked or failed an assertion.
+func expectNoRace(fn func(), n int, depth int) (passed bool) {
+	panics := make([]interface{}, n)
+
+	// Values with which fn halted a goroutine after recording a failure, as
+	// AssertEq does. These must not escape the goroutines, where nothing would
+	// recover them.
+	halts := make([]interface{}, n)
+
+	var start, done sync.WaitGroup
+	start.Add(1)
+	done.Add(n)
+
+	for i := 0; i < n; i++ {
+		go func(i int) {
+			defer done.Done()
+			defer func() { halts[i] = recover() }()
+
+			start.Wait()
+			_, panics[i] = InterceptPanics(fn)
+		}(i)
+	}
+
+	start.Done()
+	done.Wait()
+
+	// Failures that the user has asked to crash the program must do so from
+	// the test's own goroutine.
+	for _, h := range halts {
+		if isFailurePanic(h) {
+			panic(h)
+		}
+	}
+
+	passed = true
+	for _, h := range halts {
+		if h != nil {
+			passed = false
+		}
+	}
+
+	for i, p := range panics {
+		if p == nil {
+			continue
+		}
+
+		passed = false
+		msg := fmt.Sprintf("Goroutine %d of %d panicked: %v", i+1, n, p)
+		recordFailure(depth+1, msg, nil)
+	}
+
+	return
+}
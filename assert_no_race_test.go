@@ -0,0 +1,81 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"sync/atomic"
+	"testing"
+)
+
+func TestExpectNoRace(t *testing.T) {
+	setUpCurrentTest()
+
+	var calls int32
+	if !expectNoRace(func() { atomic.AddInt32(&calls, 1) }, 4, 0) {
+		t.Errorf("Expected success.")
+	}
+
+	expectEqInt(t, 4, int(atomic.LoadInt32(&calls)))
+	assertEqInt(t, 0, len(currentlyRunningTest.failureRecords))
+
+	// Panic in every other goroutine.
+	atomic.StoreInt32(&calls, 0)
+	passed := expectNoRace(
+		func() {
+			if atomic.AddInt32(&calls, 1)%2 == 0 {
+				panic("taco")
+			}
+		},
+		4,
+		0)
+
+	if passed {
+		t.Errorf("Expected failure.")
+	}
+
+	assertEqInt(t, 2, len(currentlyRunningTest.failureRecords))
+	expectEqStr(t, "assert_no_race_test.go", currentlyRunningTest.failureRecords[0].FileName)
+}
+
+func TestAssertNoRaceWithFailingAssertion(t *testing.T) {
+	setUpCurrentTest()
+
+	aborted := false
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				if !isAbortError(r) {
+					panic(r)
+				}
+
+				aborted = true
+			}
+		}()
+
+		AssertNoRace(func() { AssertEq(17, 19) })
+	}()
+
+	if !aborted {
+		t.Errorf("Expected the test to be aborted.")
+	}
+
+	// The failures from each goroutine are folded into one record.
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+	record := currentlyRunningTest.failureRecords[0]
+
+	expectEqStr(t, "assert_no_race_test.go", record.FileName)
+	expectEqStr(t, "Expected: 17\nActual:   19", record.Error)
+}
@@ -0,0 +1,71 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import "github.com/jacobsa/oglematchers"
+
+// Assertion is a value about which expectations or assertions can be made
+// using method chaining. See Expect and Assert.
+type Assertion struct {
+	x     interface{}
+	fatal bool
+}
+
+// Expect returns an Assertion for x, allowing expectations to be written as
+// English-like sentences. Each method of the result is equivalent to
+// ExpectThat.
+//
+// For example:
+//
+//     Expect(userName).To(Equals("jacobsa"))
+//     Expect(err).NotTo(HaveOccurred(), "while loading %s", path)
+//
+func Expect(x interface{}) *Assertion {
+	return &Assertion{x: x}
+}
+
+// Assert is like Expect, except that each method of the result is equivalent
+// to AssertThat.
+func Assert(x interface{}) *Assertion {
+	return &Assertion{x: x, fatal: true}
+}
+
+// To(m) is equivalent to ExpectThat(x, m) or AssertThat(x, m), where x is the
+// value given to Expect or Assert.
+func (a *Assertion) To(m oglematchers.Matcher, errorParts ...interface{}) {
+	a.check(m, errorParts)
+}
+
+// NotTo(m) is equivalent to To(oglematchers.Not(m)).
+func (a *Assertion) NotTo(m oglematchers.Matcher, errorParts ...interface{}) {
+	a.check(oglematchers.Not(m), errorParts)
+}
+
+// ToNot is a synonym for NotTo.
+func (a *Assertion) ToNot(m oglematchers.Matcher, errorParts ...interface{}) {
+	a.check(oglematchers.Not(m), errorParts)
+}
+
+// Check m against the value, attributing any failure to the frame two above
+// this one.
+func (a *Assertion) check(m oglematchers.Matcher, errorParts []interface{}) {
+	if a.fatal {
+		assertThat(a.x, m, 2, errorParts)
+		return
+	}
+
+	expectThat(a.x, m, 2, errorParts)
+}
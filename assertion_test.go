@@ -0,0 +1,61 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"testing"
+
+	. "github.com/jacobsa/oglematchers"
+)
+
+func TestExpectTo(t *testing.T) {
+	setUpCurrentTest()
+	Expect(17).To(Equals(17))
+	Expect(17).To(Equals(19), "taco %d", 1)
+	Expect(17).NotTo(Equals(19))
+
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+	record := currentlyRunningTest.failureRecords[0]
+
+	expectEqStr(t, "assertion_test.go", record.FileName)
+	expectEqStr(t, "Expected: 19\nActual:   17\ntaco 1", record.Error)
+}
+
+func TestAssertTo(t *testing.T) {
+	setUpCurrentTest()
+
+	aborted := false
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				if !isAbortError(r) {
+					panic(r)
+				}
+
+				aborted = true
+			}
+		}()
+
+		Assert(17).ToNot(Equals(17))
+	}()
+
+	if !aborted {
+		t.Errorf("Expected the test to be aborted.")
+	}
+
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+	expectEqStr(t, "assertion_test.go", currentlyRunningTest.failureRecords[0].FileName)
+}
@@ -0,0 +1,72 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import "reflect"
+
+// BaseSuite may be embedded in a test suite struct to give the suite a
+// subject of type T, created afresh for each test method by the suite's
+// SetUpSubject method. The subject is available to SetUp, the test method,
+// and TearDown via the Subject method.
+//
+// Suites embedding BaseSuite must define SetUpSubject with the signature
+// shown below. It is called before SetUp, and neither it nor Subject is
+// treated as a test method.
+//
+// For example:
+//
+//     type ServerTest struct {
+//       ogletest.BaseSuite[*Server]
+//     }
+//
+//     func init() { ogletest.RegisterTestSuite(&ServerTest{}) }
+//
+//     func (t *ServerTest) SetUpSubject() *Server {
+//       return NewServer(":0")
+//     }
+//
+//     func (t *ServerTest) TearDown() {
+//       t.Subject().Close()
+//     }
+//
+//     func (t *ServerTest) StartsIdle() {
+//       ExpectEq(0, t.Subject().NumConnections())
+//     }
+//
+type BaseSuite[T any] struct {
+	subject    T
+	subjectSet bool
+}
+
+// Subject returns the value returned by the suite's SetUpSubject method for
+// the current test. It panics if SetUpSubject has not yet run.
+func (s *BaseSuite[T]) Subject() T {
+	if !s.subjectSet {
+		panic("Subject called before SetUpSubject has run.")
+	}
+
+	return s.subject
+}
+
+func (s *BaseSuite[T]) subjectType() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// v must be assignable to T, as checked when the suite was registered.
+func (s *BaseSuite[T]) setSubject(v reflect.Value) {
+	reflect.ValueOf(&s.subject).Elem().Set(v)
+	s.subjectSet = true
+}
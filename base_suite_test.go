@@ -0,0 +1,85 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import "testing"
+
+type subjectSuite struct {
+	BaseSuite[*int]
+	seenInSetUp int
+}
+
+func (t *subjectSuite) SetUpSubject() *int {
+	x := 17
+	return &x
+}
+
+func (t *subjectSuite) SetUp(ti *TestInfo) {
+	t.seenInSetUp = *t.Subject()
+}
+
+func (t *subjectSuite) SomeTest() {
+	if t.seenInSetUp != 17 {
+		panic("Subject not set before SetUp.")
+	}
+}
+
+func TestBaseSuite(t *testing.T) {
+	saved := registeredSuites
+	defer func() { registeredSuites = saved }()
+
+	RegisterTestSuite(&subjectSuite{})
+	suite := registeredSuites[len(registeredSuites)-1]
+
+	assertEqInt(t, 1, len(suite.TestFunctions))
+	tf := suite.TestFunctions[0]
+	expectEqStr(t, "SomeTest", tf.Name)
+
+	setUpCurrentTest()
+	tf.SetUp(currentlyRunningTest)
+	tf.Run()
+}
+
+type mismatchedSubjectSuite struct {
+	BaseSuite[*int]
+}
+
+func (t *mismatchedSubjectSuite) SetUpSubject() string {
+	return "taco"
+}
+
+func (t *mismatchedSubjectSuite) SomeTest() {
+}
+
+func TestBaseSuiteMismatchedSubject(t *testing.T) {
+	saved := registeredSuites
+	defer func() { registeredSuites = saved }()
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatalf("Expected a panic.")
+		}
+
+		expectEqStr(
+			t,
+			"mismatchedSubjectSuite: SetUpSubject returns string, which is not "+
+				"assignable to the BaseSuite subject type *int.",
+			r.(string))
+	}()
+
+	RegisterTestSuite(&mismatchedSubjectSuite{})
+}
@@ -0,0 +1,81 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"bytes"
+	"io"
+	"os"
+)
+
+// CaptureOutput calls f with os.Stdout and os.Stderr redirected to pipes,
+// returning whatever f wrote to each. The original files are restored when f
+// returns, even if it panics.
+//
+// Only writes that go through the os.Stdout and os.Stderr variables (as with
+// the fmt and log packages) are captured; writes made directly to file
+// descriptors 1 and 2 are not.
+func CaptureOutput(f func()) (stdout, stderr string) {
+	outR, outW, err := os.Pipe()
+	if err != nil {
+		panic("CaptureOutput: os.Pipe: " + err.Error())
+	}
+
+	errR, errW, err := os.Pipe()
+	if err != nil {
+		outR.Close()
+		outW.Close()
+		panic("CaptureOutput: os.Pipe: " + err.Error())
+	}
+
+	// Drain the pipes in the background so that f can't block on a full pipe.
+	outC := drainPipe(outR)
+	errC := drainPipe(errR)
+
+	originalStdout := os.Stdout
+	originalStderr := os.Stderr
+	os.Stdout = outW
+	os.Stderr = errW
+
+	defer func() {
+		os.Stdout = originalStdout
+		os.Stderr = originalStderr
+
+		outW.Close()
+		errW.Close()
+
+		stdout = <-outC
+		stderr = <-errC
+	}()
+
+	f()
+	return
+}
+
+// Read everything from r in the background, closing it when done and sending
+// the result on the returned channel.
+func drainPipe(r *os.File) <-chan string {
+	c := make(chan string, 1)
+	go func() {
+		defer r.Close()
+
+		var buf bytes.Buffer
+		io.Copy(&buf, r)
+		c <- buf.String()
+	}()
+
+	return c
+}
@@ -0,0 +1,52 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"fmt"
+	"os"
+	"testing"
+)
+
+func TestCaptureOutput(t *testing.T) {
+	originalStdout := os.Stdout
+
+	stdout, stderr := CaptureOutput(func() {
+		fmt.Print("taco")
+		fmt.Fprint(os.Stderr, "burrito")
+	})
+
+	expectEqStr(t, "taco", stdout)
+	expectEqStr(t, "burrito", stderr)
+
+	if os.Stdout != originalStdout {
+		t.Errorf("os.Stdout was not restored.")
+	}
+}
+
+func TestCaptureOutputRestoresOnPanic(t *testing.T) {
+	originalStdout := os.Stdout
+	originalStderr := os.Stderr
+
+	func() {
+		defer func() { recover() }()
+		CaptureOutput(func() { panic("taco") })
+	}()
+
+	if os.Stdout != originalStdout || os.Stderr != originalStderr {
+		t.Errorf("Output files were not restored.")
+	}
+}
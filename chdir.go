@@ -0,0 +1,48 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"fmt"
+	"os"
+)
+
+// Chdir changes the working directory to dir for the remainder of the
+// currently running test. The original working directory is restored after
+// the test's TearDown method has run.
+func Chdir(dir string) {
+	info := currentlyRunningTest
+	if info == nil {
+		panic("Chdir: no test info.")
+	}
+
+	original, err := os.Getwd()
+	if err != nil {
+		recordFailure(1, fmt.Sprintf("Chdir: Getwd: %v", err), nil)
+		AbortTest()
+	}
+
+	if err := os.Chdir(dir); err != nil {
+		recordFailure(1, fmt.Sprintf("Chdir: %v", err), nil)
+		AbortTest()
+	}
+
+	info.addCleanup(func() {
+		if err := os.Chdir(original); err != nil {
+			panic(fmt.Sprintf("Chdir: restoring %s: %v", original, err))
+		}
+	})
+}
@@ -0,0 +1,98 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+)
+
+// ANSI SGR parameters for the colors used in ogletest's output.
+const (
+	colorRed    = "31"
+	colorGreen  = "32"
+	colorYellow = "33"
+)
+
+// Colorize wraps s in the ANSI escape sequences for the supplied SGR parameter
+// (e.g. "31" for red, or "1;32" for bold green) if color output is enabled,
+// and otherwise returns s unchanged. Color output is enabled by
+// --ogletest.color and disabled by --ogletest.color=false. By default it is
+// enabled only when stdout is a terminal.
+func Colorize(s, code string) string {
+	if !colorEnabled() {
+		return s
+	}
+
+	return fmt.Sprintf("\x1b[%sm%s\x1b[0m", code, s)
+}
+
+// Return true iff output should be colorized, according to the
+// --ogletest.color flag.
+func colorEnabled() bool {
+	if fColor.value != nil {
+		return *fColor.value
+	}
+
+	return stdoutIsTerminal()
+}
+
+// Return true iff stdout is a terminal. This is checked only once, since
+// Colorize is called for every line of output.
+var stdoutIsTerminal = sync.OnceValue(func() bool {
+	fi, err := os.Stdout.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+})
+
+// A boolean flag that may also be left unset, in which case the caller
+// chooses a default automatically. Like flags created with flag.Bool, it may
+// be given without a value to set it to true.
+type autoBoolFlag struct {
+	// nil if the flag has not been set.
+	value *bool
+}
+
+// Define an autoBoolFlag with the supplied name and usage string.
+func newAutoBoolFlag(name string, usage string) *autoBoolFlag {
+	f := &autoBoolFlag{}
+	flag.Var(f, name, usage)
+	return f
+}
+
+func (f *autoBoolFlag) String() string {
+	if f == nil || f.value == nil {
+		return "auto"
+	}
+
+	return strconv.FormatBool(*f.value)
+}
+
+func (f *autoBoolFlag) Set(s string) error {
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return err
+	}
+
+	f.value = &v
+	return nil
+}
+
+func (f *autoBoolFlag) IsBoolFlag() bool {
+	return true
+}
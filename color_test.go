@@ -0,0 +1,50 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"flag"
+	"testing"
+)
+
+func TestColorize(t *testing.T) {
+	defer func(v *bool) { fColor.value = v }(fColor.value)
+
+	fColor.Set("false")
+	expectEqStr(t, "taco", Colorize("taco", colorRed))
+
+	fColor.Set("true")
+	expectEqStr(t, "\x1b[31mtaco\x1b[0m", Colorize("taco", colorRed))
+	expectEqStr(t, "\x1b[1;32mtaco\x1b[0m", Colorize("taco", "1;32"))
+}
+
+func TestAutoBoolFlag(t *testing.T) {
+	parse := func(args ...string) *autoBoolFlag {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		f := &autoBoolFlag{}
+		fs.Var(f, "color", "")
+		if err := fs.Parse(args); err != nil {
+			t.Fatalf("Parse(%q): %v", args, err)
+		}
+
+		return f
+	}
+
+	expectEqStr(t, "auto", parse().String())
+	expectEqStr(t, "true", parse("--color").String())
+	expectEqStr(t, "true", parse("--color=true").String())
+	expectEqStr(t, "false", parse("--color=false").String())
+}
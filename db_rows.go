@@ -0,0 +1,175 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// DBRows returns a matcher for *sql.Rows values that matches result sets
+// containing exactly the supplied rows, in order. Each cell is compared with
+// reflect.DeepEqual against the value that database/sql scans into an
+// interface{}, so expected values must have the types the driver produces
+// (e.g. int64 rather than int, and nil for NULL).
+//
+// Matching reads all remaining rows from the candidate and closes it.
+//
+// For example:
+//
+//     rows, err := db.Query("SELECT id, name FROM foods ORDER BY id")
+//     AssertEq(nil, err)
+//
+//     ExpectThat(rows, DBRows([][]interface{}{
+//       {int64(1), "taco"},
+//       {int64(2), "burrito"},
+//     }))
+//
+func DBRows(expectedRows [][]interface{}) oglematchers.Matcher {
+	return &dbRowsMatcher{expectedRows}
+}
+
+type dbRowsMatcher struct {
+	expected [][]interface{}
+}
+
+func (m *dbRowsMatcher) Description() string {
+	return "rows:\n" + formatGrid(m.expected)
+}
+
+func (m *dbRowsMatcher) Matches(c interface{}) error {
+	rows, ok := c.(*sql.Rows)
+	if !ok || rows == nil {
+		return oglematchers.NewFatalError("which is not a *sql.Rows")
+	}
+
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return oglematchers.NewFatalError(fmt.Sprintf("which failed: %v", err))
+	}
+
+	// Report a difference in the shape of the table separately, since the cells
+	// can't sensibly be compared.
+	for i, row := range m.expected {
+		if len(row) != len(cols) {
+			return errors.New(fmt.Sprintf(
+				"which has %d columns, but expected row %d has %d",
+				len(cols),
+				i+1,
+				len(row)))
+		}
+	}
+
+	actual, err := scanAll(rows, len(cols))
+	if err != nil {
+		return oglematchers.NewFatalError(fmt.Sprintf("which failed: %v", err))
+	}
+
+	// Find the first differing cell, if any.
+	var where string
+	for i := 0; i < len(actual) && i < len(m.expected) && where == ""; i++ {
+		for j := range cols {
+			if !reflect.DeepEqual(actual[i][j], m.expected[i][j]) {
+				where = fmt.Sprintf("differs at row %d, column %d", i+1, j+1)
+				break
+			}
+		}
+	}
+
+	if where == "" && len(actual) != len(m.expected) {
+		where = fmt.Sprintf("has %d rows", len(actual))
+	}
+
+	if where == "" {
+		return nil
+	}
+
+	return errors.New(fmt.Sprintf("which %s:\n%s", where, formatGrid(actual)))
+}
+
+// Scan all remaining rows, each of which has n columns.
+func scanAll(rows *sql.Rows, n int) (out [][]interface{}, err error) {
+	for rows.Next() {
+		row := make([]interface{}, n)
+		ptrs := make([]interface{}, n)
+		for i := range row {
+			ptrs[i] = &row[i]
+		}
+
+		if err = rows.Scan(ptrs...); err != nil {
+			return
+		}
+
+		out = append(out, row)
+	}
+
+	err = rows.Err()
+	return
+}
+
+// Format the supplied rows as a grid with aligned columns, one line per row.
+// NULL values are shown as NULL, and byte slices as strings.
+func formatGrid(rows [][]interface{}) string {
+	if len(rows) == 0 {
+		return "  (no rows)"
+	}
+
+	cells := make([][]string, len(rows))
+	var widths []int
+	for i, row := range rows {
+		for j, v := range row {
+			var s string
+			switch v := v.(type) {
+			case nil:
+				s = "NULL"
+
+			case []byte:
+				s = string(v)
+
+			default:
+				s = fmt.Sprintf("%v", v)
+			}
+
+			cells[i] = append(cells[i], s)
+			if j >= len(widths) {
+				widths = append(widths, 0)
+			}
+
+			if len(s) > widths[j] {
+				widths[j] = len(s)
+			}
+		}
+	}
+
+	lines := make([]string, len(cells))
+	for i, row := range cells {
+		line := "  |"
+		for j, s := range row {
+			line += fmt.Sprintf(" %-*s |", widths[j], s)
+		}
+
+		lines[i] = line
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,174 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////////////
+// Fake driver
+////////////////////////////////////////////////////////////////////////
+
+// A driver whose every query returns the same table: the rows of fakeDBTable,
+// with columns named by fakeDBColumns.
+type fakeDriver struct{}
+
+var fakeDBColumns = []string{"id", "name", "note"}
+var fakeDBTable = [][]driver.Value{
+	{int64(1), "taco", nil},
+	{int64(2), "burrito", []byte("spicy")},
+}
+
+func init() {
+	sql.Register("ogletest_fake", fakeDriver{})
+}
+
+func (fakeDriver) Open(string) (driver.Conn, error) { return fakeConn{}, nil }
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(string) (driver.Stmt, error) { return fakeStmt{}, nil }
+func (fakeConn) Close() error                        { return nil }
+func (fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("no tx") }
+
+type fakeStmt struct{}
+
+func (fakeStmt) Close() error                               { return nil }
+func (fakeStmt) NumInput() int                              { return -1 }
+func (fakeStmt) Exec([]driver.Value) (driver.Result, error) { return nil, errors.New("no exec") }
+func (fakeStmt) Query([]driver.Value) (driver.Rows, error)  { return &fakeRows{}, nil }
+
+type fakeRows struct {
+	next int
+}
+
+func (r *fakeRows) Columns() []string { return fakeDBColumns }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next >= len(fakeDBTable) {
+		return io.EOF
+	}
+
+	copy(dest, fakeDBTable[r.next])
+	r.next++
+	return nil
+}
+
+func queryFakeDB(t *testing.T) *sql.Rows {
+	db, err := sql.Open("ogletest_fake", "")
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+
+	rows, err := db.Query("SELECT * FROM foods")
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+
+	return rows
+}
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func TestDBRows(t *testing.T) {
+	cases := []struct {
+		expected [][]interface{}
+		err      string
+	}{
+		// Exact match.
+		{
+			[][]interface{}{
+				{int64(1), "taco", nil},
+				{int64(2), "burrito", []byte("spicy")},
+			},
+			"",
+		},
+
+		// Wrong cell.
+		{
+			[][]interface{}{
+				{int64(1), "taco", nil},
+				{int64(2), "enchilada", []byte("spicy")},
+			},
+			"which differs at row 2, column 2:\n" +
+				"  | 1 | taco    | NULL  |\n" +
+				"  | 2 | burrito | spicy |",
+		},
+
+		// Too few rows.
+		{
+			[][]interface{}{
+				{int64(1), "taco", nil},
+			},
+			"which has 2 rows:\n" +
+				"  | 1 | taco    | NULL  |\n" +
+				"  | 2 | burrito | spicy |",
+		},
+
+		// Wrong number of columns.
+		{
+			[][]interface{}{
+				{int64(1), "taco"},
+			},
+			"which has 3 columns, but expected row 1 has 2",
+		},
+	}
+
+	for i, c := range cases {
+		var actual string
+		if err := DBRows(c.expected).Matches(queryFakeDB(t)); err != nil {
+			actual = err.Error()
+		}
+
+		if actual != c.err {
+			t.Errorf("Case %d: expected %q, got %q", i, c.err, actual)
+		}
+	}
+}
+
+func TestDBRowsDescription(t *testing.T) {
+	m := DBRows([][]interface{}{
+		{int64(17), "taco"},
+		{int64(2), nil},
+	})
+
+	expectEqStr(
+		t,
+		strings.Join([]string{
+			"rows:",
+			"  | 17 | taco |",
+			"  | 2  | NULL |",
+		}, "\n"),
+		m.Description())
+
+	expectEqStr(t, "rows:\n  (no rows)", DBRows(nil).Description())
+}
+
+func TestDBRowsWrongType(t *testing.T) {
+	err := DBRows(nil).Matches("taco")
+	if err == nil || err.Error() != "which is not a *sql.Rows" {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Return a description of the first difference found between want and got,
+// in the form "at got.Foo[2]: want 17, got 19". Return the empty string if
+// they are deeply equal.
+func firstDifference(want, got interface{}) string {
+	return firstDifferenceWithOptions(want, got, nil)
+}
+
+// Like firstDifference, but skip the struct fields that the supplied options,
+// which may be nil, say to ignore.
+func firstDifferenceWithOptions(
+	want, got interface{},
+	opts *matchOptions) string {
+	d := &differ{
+		visited: make(map[[2]uintptr]bool),
+		opts:    opts,
+	}
+
+	path, w, g, found := d.diffValues(
+		"got",
+		reflect.ValueOf(want),
+		reflect.ValueOf(got))
+
+	if !found {
+		return ""
+	}
+
+	return fmt.Sprintf("at %s: want %s, got %s", path, w, g)
+}
+
+type differ struct {
+	// Pairs of pointers already being compared, used to avoid looping forever
+	// on cyclic data structures.
+	visited map[[2]uintptr]bool
+
+	// Options controlling which struct fields to compare. May be nil.
+	opts *matchOptions
+}
+
+// Find the first difference between want and got, which are at the supplied
+// path, returning the path to it and descriptions of the values there.
+func (d *differ) diffValues(
+	path string,
+	want, got reflect.Value) (p string, w string, g string, found bool) {
+	// Report a difference at the current path.
+	here := func(w, g interface{}) (string, string, string, bool) {
+		return path, fmt.Sprintf("%v", w), fmt.Sprintf("%v", g), true
+	}
+
+	if !want.IsValid() || !got.IsValid() {
+		if want.IsValid() != got.IsValid() {
+			return here(want, got)
+		}
+
+		return
+	}
+
+	if want.Type() != got.Type() {
+		return here(want.Type(), got.Type())
+	}
+
+	switch want.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if want.IsNil() || got.IsNil() {
+			if want.IsNil() != got.IsNil() {
+				return here(want, got)
+			}
+
+			return
+		}
+
+		if want.Kind() == reflect.Ptr {
+			key := [2]uintptr{want.Pointer(), got.Pointer()}
+			if key[0] == key[1] || d.visited[key] {
+				return
+			}
+
+			d.visited[key] = true
+		}
+
+		return d.diffValues(path, want.Elem(), got.Elem())
+
+	case reflect.Struct:
+		for i := 0; i < want.NumField(); i++ {
+			if d.opts.ignoresField(want.Type().Field(i)) {
+				continue
+			}
+
+			p, w, g, found = d.diffValues(
+				path+"."+want.Type().Field(i).Name,
+				want.Field(i),
+				got.Field(i))
+
+			if found {
+				return
+			}
+		}
+
+		return
+
+	case reflect.Slice, reflect.Array:
+		if want.Kind() == reflect.Slice && want.IsNil() != got.IsNil() {
+			return here(want, got)
+		}
+
+		n := want.Len()
+		if got.Len() < n {
+			n = got.Len()
+		}
+
+		for i := 0; i < n; i++ {
+			p, w, g, found = d.diffValues(
+				fmt.Sprintf("%s[%d]", path, i),
+				want.Index(i),
+				got.Index(i))
+
+			if found {
+				return
+			}
+		}
+
+		if want.Len() != got.Len() {
+			return path + ".len()", fmt.Sprint(want.Len()), fmt.Sprint(got.Len()), true
+		}
+
+		return
+
+	case reflect.Map:
+		if want.IsNil() != got.IsNil() {
+			return here(want, got)
+		}
+
+		// Visit keys in a deterministic order.
+		keys := append(want.MapKeys(), got.MapKeys()...)
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprintf("%v", keys[i]) < fmt.Sprintf("%v", keys[j])
+		})
+
+		for _, k := range keys {
+			kp := fmt.Sprintf("%s[%#v]", path, k)
+			wv := want.MapIndex(k)
+			gv := got.MapIndex(k)
+
+			switch {
+			case !wv.IsValid() && gv.IsValid():
+				return kp, "<missing>", fmt.Sprintf("%v", gv), true
+
+			case wv.IsValid() && !gv.IsValid():
+				return kp, fmt.Sprintf("%v", wv), "<missing>", true
+			}
+
+			if p, w, g, found = d.diffValues(kp, wv, gv); found {
+				return
+			}
+		}
+
+		return
+
+	case reflect.Func:
+		if want.IsNil() && got.IsNil() {
+			return
+		}
+
+		return here("func", "func")
+
+	case reflect.Chan, reflect.UnsafePointer:
+		if want.Pointer() != got.Pointer() {
+			return here(want, got)
+		}
+
+		return
+	}
+
+	if !leavesEqual(want, got) {
+		return here(want, got)
+	}
+
+	return
+}
+
+// Compare two values of the same basic kind, without requiring that they be
+// exported.
+func leavesEqual(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		return a.Uint() == b.Uint()
+
+	case reflect.Float32, reflect.Float64:
+		return a.Float() == b.Float()
+
+	case reflect.Complex64, reflect.Complex128:
+		return a.Complex() == b.Complex()
+
+	case reflect.String:
+		return a.String() == b.String()
+	}
+
+	panic(fmt.Sprintf("leavesEqual: unexpected kind %v", a.Kind()))
+}
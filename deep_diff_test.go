@@ -0,0 +1,75 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import "testing"
+
+type diffNode struct {
+	Name     string
+	Children []*diffNode
+	Labels   map[string]int
+	parent   *diffNode
+}
+
+func TestFirstDifference(t *testing.T) {
+	cases := []struct {
+		want     interface{}
+		got      interface{}
+		expected string
+	}{
+		{17, 17, ""},
+		{17, 19, "at got: want 17, got 19"},
+		{17, "taco", "at got: want int, got string"},
+		{[]int{1, 2}, []int{1, 3}, "at got[1]: want 2, got 3"},
+		{[]int{1, 2}, []int{1, 2, 3}, "at got.len(): want 2, got 3"},
+		{
+			map[string]int{"a": 1},
+			map[string]int{"a": 1, "b": 2},
+			`at got["b"]: want <missing>, got 2`,
+		},
+		{
+			&diffNode{Name: "root", Children: []*diffNode{{Name: "taco"}}},
+			&diffNode{Name: "root", Children: []*diffNode{{Name: "burrito"}}},
+			"at got.Children[0].Name: want taco, got burrito",
+		},
+		{
+			diffNode{Labels: map[string]int{"x": 1}},
+			diffNode{Labels: map[string]int{"x": 2}},
+			`at got.Labels["x"]: want 1, got 2`,
+		},
+	}
+
+	for i, c := range cases {
+		if actual := firstDifference(c.want, c.got); actual != c.expected {
+			t.Errorf("Case %d: expected %q, got %q", i, c.expected, actual)
+		}
+	}
+}
+
+func TestFirstDifferenceCycles(t *testing.T) {
+	makeCycle := func(name string) *diffNode {
+		n := &diffNode{Name: "root"}
+		n.Children = []*diffNode{{Name: name, parent: n}}
+		n.Children[0].Children = []*diffNode{n}
+		return n
+	}
+
+	expectEqStr(t, "", firstDifference(makeCycle("taco"), makeCycle("taco")))
+	expectEqStr(
+		t,
+		"at got.Children[0].Name: want taco, got burrito",
+		firstDifference(makeCycle("taco"), makeCycle("burrito")))
+}
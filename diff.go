@@ -0,0 +1,308 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The number of unchanged lines shown around each change in a unified diff.
+const diffContext = 3
+
+// A single line of a line-based diff.
+type diffLine struct {
+	// ' ' for a line common to both inputs, '-' for a line only in the first,
+	// and '+' for a line only in the second.
+	kind byte
+	text string
+}
+
+// Compute a minimal line-based diff transforming a into b, using Myers' O(ND)
+// algorithm ("An O(ND) Difference Algorithm and Its Variations", 1986).
+func diffLines(a, b []string) []diffLine {
+	n, m := len(a), len(b)
+	max := n + m
+	offset := max + 1
+
+	// v[k+offset] is the furthest x reached so far on diagonal k (x - y = k).
+	// In order to backtrack we record, before each step d, the entries for
+	// diagonals -d through d, the only ones that step reads. This keeps memory
+	// at O(D^2) rather than O(D*(N+M)).
+	v := make([]int, 2*max+3)
+	var trace [][]int
+
+search:
+	for d := 0; d <= max; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d:offset+d+1]...))
+		for k := -d; k <= d; k += 2 {
+			// Move down (an insertion) or right (a deletion), whichever reaches
+			// further.
+			var x int
+			if k == -d || (k != d && v[k-1+offset] < v[k+1+offset]) {
+				x = v[k+1+offset]
+			} else {
+				x = v[k-1+offset] + 1
+			}
+
+			// Follow the diagonal of matching lines as far as possible.
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+
+			v[k+offset] = x
+			if x >= n && y >= m {
+				break search
+			}
+		}
+	}
+
+	// Backtrack through the trace to recover the edit script, in reverse.
+	var out []diffLine
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0 && (x > 0 || y > 0); d-- {
+		// trace[d][k+d] holds v[k+offset] as it was before step d.
+		v := trace[d]
+		k := x - y
+
+		var prevK int
+		if k == -d || (k != d && v[k-1+d] < v[k+1+d]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+
+		// Step 0 starts from the origin, outside the recorded diagonals.
+		var prevX, prevY int
+		if d > 0 {
+			prevX = v[prevK+d]
+			prevY = prevX - prevK
+		}
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			out = append(out, diffLine{' ', a[x]})
+		}
+
+		if d == 0 {
+			break
+		}
+
+		if x == prevX {
+			y--
+			out = append(out, diffLine{'+', b[y]})
+		} else {
+			x--
+			out = append(out, diffLine{'-', a[x]})
+		}
+	}
+
+	// Reverse into forward order.
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+
+	return out
+}
+
+// Split s into lines for diffing, each keeping its newline. A final line that
+// lacks one therefore differs from the same line with one.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	// A trailing newline leaves an empty string at the end.
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+// Diff returns a line-based unified diff transforming want into got, or the
+// empty string if they are equal. It is suitable for embedding in failure
+// messages about multi-line strings.
+//
+// Diff doesn't back the failure messages of DeepEquals, which is defined in
+// oglematchers rather than here; ExpectDeepEqual and DeepEqualTo instead
+// report the first difference found within their arguments.
+//
+// For example:
+//
+//     if d := Diff(expected, actual); d != "" {
+//       AddFailure("Output differs:\n%s", d)
+//     }
+//
+func Diff(want, got string) string {
+	return formatDiff(want, got, false)
+}
+
+// Like Diff, but prefix each line of the diff with its line numbers in
+// want and got, for easier reading.
+func numberedDiff(want, got string) string {
+	return formatDiff(want, got, true)
+}
+
+func formatDiff(want, got string, numbered bool) string {
+	if want == got {
+		return ""
+	}
+
+	lines := diffLines(splitLines(want), splitLines(got))
+
+	var buf strings.Builder
+	buf.WriteString("--- want\n+++ got\n")
+
+	// Walk the lines, emitting a hunk for each group of changes that are
+	// within 2*diffContext lines of each other.
+	wantLine, gotLine := 1, 1
+	for start := 0; start < len(lines); {
+		// Find the next change.
+		first := start
+		for first < len(lines) && lines[first].kind == ' ' {
+			first++
+		}
+
+		if first == len(lines) {
+			break
+		}
+
+		// Extend the hunk until there's a long enough run of unchanged lines.
+		end := first
+		for end < len(lines) {
+			if lines[end].kind != ' ' {
+				end++
+				continue
+			}
+
+			run := end
+			for run < len(lines) && lines[run].kind == ' ' {
+				run++
+			}
+
+			if run == len(lines) || run-end > 2*diffContext {
+				break
+			}
+
+			end = run
+		}
+
+		// Add context on either side.
+		hunkStart := first - diffContext
+		if hunkStart < start {
+			hunkStart = start
+		}
+
+		hunkEnd := end + diffContext
+		if hunkEnd > len(lines) {
+			hunkEnd = len(lines)
+		}
+
+		// Advance the line counters to the start of the hunk.
+		for _, l := range lines[start:hunkStart] {
+			wantLine, gotLine = advanceDiffLines(l, wantLine, gotLine)
+		}
+
+		var wantCount, gotCount int
+		for _, l := range lines[hunkStart:hunkEnd] {
+			if l.kind != '+' {
+				wantCount++
+			}
+
+			if l.kind != '-' {
+				gotCount++
+			}
+		}
+
+		fmt.Fprintf(
+			&buf,
+			"@@ -%s +%s @@\n",
+			hunkRange(wantLine, wantCount),
+			hunkRange(gotLine, gotCount))
+
+		for _, l := range lines[hunkStart:hunkEnd] {
+			if numbered {
+				fmt.Fprintf(
+					&buf,
+					"%c%5s %5s | %s",
+					l.kind,
+					diffLineNumber(l, '+', wantLine),
+					diffLineNumber(l, '-', gotLine),
+					diffLineText(l))
+			} else {
+				fmt.Fprintf(&buf, "%c%s", l.kind, diffLineText(l))
+			}
+
+			wantLine, gotLine = advanceDiffLines(l, wantLine, gotLine)
+		}
+
+		start = hunkEnd
+	}
+
+	return buf.String()
+}
+
+// Return the line numbers following the supplied diff line.
+func advanceDiffLines(l diffLine, wantLine, gotLine int) (int, int) {
+	if l.kind != '+' {
+		wantLine++
+	}
+
+	if l.kind != '-' {
+		gotLine++
+	}
+
+	return wantLine, gotLine
+}
+
+// Format the line number of l within one input, or return the empty string if
+// l is of the kind absent from that input.
+func diffLineNumber(l diffLine, absent byte, line int) string {
+	if l.kind == absent {
+		return ""
+	}
+
+	return fmt.Sprintf("%d", line)
+}
+
+// Return the text of l terminated by a newline, followed by a marker if the
+// line lacks one of its own, as in the output of diff(1).
+func diffLineText(l diffLine) string {
+	if strings.HasSuffix(l.text, "\n") {
+		return l.text
+	}
+
+	return l.text + "\n\\ No newline at end of file\n"
+}
+
+// Format a range for a unified diff hunk header. By convention an empty range
+// is identified by the line before it.
+func hunkRange(line, count int) string {
+	if count == 0 {
+		line--
+	}
+
+	if count == 1 {
+		return fmt.Sprintf("%d", line)
+	}
+
+	return fmt.Sprintf("%d,%d", line, count)
+}
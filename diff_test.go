@@ -0,0 +1,138 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	expectEqStr(t, "", Diff("taco\n", "taco\n"))
+
+	want := "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\n"
+	got := "a\nB\nc\nd\ne\nf\ng\nh\ni\nj\nk\n"
+
+	expectEqStr(
+		t,
+		"--- want\n+++ got\n"+
+			"@@ -1,5 +1,5 @@\n a\n-b\n+B\n c\n d\n e\n"+
+			"@@ -8,3 +8,4 @@\n h\n i\n j\n+k\n",
+		Diff(want, got))
+
+	expectEqStr(
+		t,
+		"--- want\n+++ got\n@@ -0,0 +1 @@\n+taco\n",
+		Diff("", "taco\n"))
+}
+
+func TestDiffMissingFinalNewline(t *testing.T) {
+	expectEqStr(
+		t,
+		"--- want\n+++ got\n@@ -1 +1 @@\n"+
+			"-taco\n"+
+			"+taco\n"+
+			"\\ No newline at end of file\n",
+		Diff("taco\n", "taco"))
+
+	expectEqStr(
+		t,
+		"--- want\n+++ got\n@@ -1,2 +1,2 @@\n"+
+			" taco\n"+
+			"-burrito\n"+
+			"\\ No newline at end of file\n"+
+			"+burrito\n",
+		Diff("taco\nburrito", "taco\nburrito\n"))
+
+	expectEqStr(
+		t,
+		"--- want\n+++ got\n@@ -1 +1 @@\n"+
+			"-    1       | taco\n"+
+			"\\ No newline at end of file\n"+
+			"+          1 | taco\n",
+		numberedDiff("taco", "taco\n"))
+}
+
+func TestNumberedDiff(t *testing.T) {
+	expectEqStr(
+		t,
+		"--- want\n+++ got\n"+
+			"@@ -1,3 +1,3 @@\n"+
+			"     1     1 | a\n"+
+			"-    2       | b\n"+
+			"+          2 | B\n"+
+			"     3     3 | c\n",
+		numberedDiff("a\nb\nc\n", "a\nB\nc\n"))
+}
+
+func TestDiffLinesIsMinimal(t *testing.T) {
+	// The example from Myers' paper, which has an edit distance of 5.
+	a := strings.Split("ABCABBA", "")
+	b := strings.Split("CBABAC", "")
+	lines := diffLines(a, b)
+
+	var edits int
+	var gotA, gotB []string
+	for _, l := range lines {
+		if l.kind != ' ' {
+			edits++
+		}
+
+		if l.kind != '+' {
+			gotA = append(gotA, l.text)
+		}
+
+		if l.kind != '-' {
+			gotB = append(gotB, l.text)
+		}
+	}
+
+	expectEqInt(t, 5, edits)
+	expectEqStr(t, "ABCABBA", strings.Join(gotA, ""))
+	expectEqStr(t, "CBABAC", strings.Join(gotB, ""))
+}
+
+func TestDiffLinesEdgeCases(t *testing.T) {
+	testCases := []struct {
+		a, b string
+	}{
+		{"", ""},
+		{"", "abc"},
+		{"abc", ""},
+		{"abc", "abc"},
+		{"abc", "xyz"},
+		{"aaaab", "baaaa"},
+	}
+
+	for _, tc := range testCases {
+		a := strings.Split(tc.a, "")
+		b := strings.Split(tc.b, "")
+
+		var gotA, gotB []string
+		for _, l := range diffLines(a, b) {
+			if l.kind != '+' {
+				gotA = append(gotA, l.text)
+			}
+
+			if l.kind != '-' {
+				gotB = append(gotB, l.text)
+			}
+		}
+
+		expectEqStr(t, tc.a, strings.Join(gotA, ""))
+		expectEqStr(t, tc.b, strings.Join(gotB, ""))
+	}
+}
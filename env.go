@@ -0,0 +1,92 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+// Setenv sets the environment variable with the given key to value for the
+// remainder of the currently running test. The variable's original value (or
+// lack thereof) is restored after the test's TearDown method has run.
+func Setenv(key, value string) {
+	info := currentlyRunningTest
+	if info == nil {
+		panic("Setenv: no test info.")
+	}
+
+	info.addCleanup(saveEnv(key))
+
+	if err := os.Setenv(key, value); err != nil {
+		recordFailure(1, fmt.Sprintf("Setenv: %v", err), nil)
+		AbortTest()
+	}
+}
+
+// WithEnv sets each of the supplied environment variables, returning a
+// function that restores all of them to their original states. When called
+// from within a test the restore function is also run automatically after
+// the test's TearDown method, so the result may be ignored; elsewhere it
+// should be deferred. Calling the function more than once has no further
+// effect.
+//
+// For example:
+//
+//     func TestConfig(t *testing.T) {
+//       defer ogletest.WithEnv(map[string]string{
+//         "HOME": "/tmp/home",
+//         "USER": "taco",
+//       })()
+//       [...]
+//     }
+//
+func WithEnv(vars map[string]string) func() {
+	var restorers []func()
+	for key, value := range vars {
+		restorers = append(restorers, saveEnv(key))
+		if err := os.Setenv(key, value); err != nil {
+			panic(fmt.Sprintf("WithEnv: %v", err))
+		}
+	}
+
+	var once sync.Once
+	restore := func() {
+		once.Do(func() {
+			for i := len(restorers) - 1; i >= 0; i-- {
+				restorers[i]()
+			}
+		})
+	}
+
+	if info := currentlyRunningTest; info != nil {
+		info.addCleanup(restore)
+	}
+
+	return restore
+}
+
+// Return a function that restores the environment variable with the given
+// key to its current state.
+func saveEnv(key string) func() {
+	original, present := os.LookupEnv(key)
+	if !present {
+		return func() { os.Unsetenv(key) }
+	}
+
+	return func() { os.Setenv(key, original) }
+}
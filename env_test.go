@@ -0,0 +1,66 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"os"
+	"testing"
+)
+
+func TestSetenvRestoresPreviousValue(t *testing.T) {
+	const key = "OGLETEST_SETENV_TEST"
+	os.Setenv(key, "taco")
+	defer os.Unsetenv(key)
+
+	setUpCurrentTest()
+	Setenv(key, "burrito")
+	expectEqStr(t, "burrito", os.Getenv(key))
+
+	currentlyRunningTest.runCleanups()
+	expectEqStr(t, "taco", os.Getenv(key))
+}
+
+func TestSetenvUnsetsPreviouslyUnsetVariable(t *testing.T) {
+	const key = "OGLETEST_SETENV_TEST"
+	os.Unsetenv(key)
+
+	setUpCurrentTest()
+	Setenv(key, "burrito")
+	currentlyRunningTest.runCleanups()
+
+	if _, present := os.LookupEnv(key); present {
+		t.Errorf("Expected %s to be unset.", key)
+	}
+}
+
+func TestWithEnv(t *testing.T) {
+	const key1 = "OGLETEST_WITHENV_TEST_1"
+	const key2 = "OGLETEST_WITHENV_TEST_2"
+	os.Setenv(key1, "taco")
+	os.Unsetenv(key2)
+	defer os.Unsetenv(key1)
+
+	currentlyRunningTest = nil
+	restore := WithEnv(map[string]string{key1: "burrito", key2: "enchilada"})
+	expectEqStr(t, "burrito", os.Getenv(key1))
+	expectEqStr(t, "enchilada", os.Getenv(key2))
+
+	restore()
+	expectEqStr(t, "taco", os.Getenv(key1))
+	if _, present := os.LookupEnv(key2); present {
+		t.Errorf("Expected %s to be unset.", key2)
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// EqualIgnoringCase returns a matcher that matches strings equal to expected
+// under Unicode case folding, as with strings.EqualFold. This is useful for
+// values such as HTTP header names or SQL keywords whose case isn't
+// significant.
+func EqualIgnoringCase(expected string) oglematchers.Matcher {
+	return &equalIgnoringCaseMatcher{expected}
+}
+
+type equalIgnoringCaseMatcher struct {
+	expected string
+}
+
+func (m *equalIgnoringCaseMatcher) Description() string {
+	return fmt.Sprintf("equal ignoring case to %q", m.expected)
+}
+
+func (m *equalIgnoringCaseMatcher) Matches(c interface{}) error {
+	s, ok := c.(string)
+	if !ok {
+		return oglematchers.NewFatalError("which is not a string")
+	}
+
+	if strings.EqualFold(s, m.expected) {
+		return nil
+	}
+
+	return errors.New(fmt.Sprintf("which is %q", s))
+}
@@ -0,0 +1,43 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"testing"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+func TestEqualIgnoringCase(t *testing.T) {
+	m := EqualIgnoringCase("Content-Type")
+	expectEqStr(t, `equal ignoring case to "Content-Type"`, m.Description())
+
+	if err := m.Matches("content-type"); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	err := m.Matches("Content-Length")
+	if err == nil {
+		t.Fatalf("Expected an error.")
+	}
+
+	expectEqStr(t, `which is "Content-Length"`, err.Error())
+
+	err = m.Matches(17)
+	if _, ok := err.(*oglematchers.FatalError); !ok {
+		t.Errorf("Expected a fatal error, got %v", err)
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// EqualIgnoringWhitespace returns a matcher that matches strings equal to
+// expected once both have been normalized by trimming leading and trailing
+// whitespace and collapsing each internal run of whitespace to a single
+// space. This is handy for multi-line output such as generated code, where
+// indentation and line breaks are not significant.
+func EqualIgnoringWhitespace(expected string) oglematchers.Matcher {
+	return &equalIgnoringWhitespaceMatcher{
+		expected:   expected,
+		normalized: normalizeWhitespace(expected),
+	}
+}
+
+type equalIgnoringWhitespaceMatcher struct {
+	expected   string
+	normalized string
+}
+
+// Trim s and collapse its internal runs of whitespace to single spaces.
+func normalizeWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func (m *equalIgnoringWhitespaceMatcher) Description() string {
+	return fmt.Sprintf(
+		"equal ignoring whitespace to %q (normalized: %q)",
+		m.expected,
+		m.normalized)
+}
+
+func (m *equalIgnoringWhitespaceMatcher) Matches(c interface{}) error {
+	s, ok := c.(string)
+	if !ok {
+		return oglematchers.NewFatalError("which is not a string")
+	}
+
+	normalized := normalizeWhitespace(s)
+	if normalized == m.normalized {
+		return nil
+	}
+
+	return errors.New(fmt.Sprintf("which normalizes to %q", normalized))
+}
+
+// For multi-line strings, add a diff of the lines of each string, normalized
+// separately. This shows where in a large string the difference lies.
+func (m *equalIgnoringWhitespaceMatcher) DescribeMismatch(c interface{}) string {
+	s, _ := c.(string)
+	text := fmt.Sprintf("which normalizes to %q", normalizeWhitespace(s))
+
+	if !strings.Contains(s, "\n") && !strings.Contains(m.expected, "\n") {
+		return text
+	}
+
+	d := Diff(normalizeLines(m.expected), normalizeLines(s))
+	if d == "" {
+		return text
+	}
+
+	return fmt.Sprintf("%s; line by line:\n%s", text, d)
+}
+
+// Normalize each line of s as with normalizeWhitespace, dropping those that
+// are left empty. Each remaining line is terminated by a newline.
+func normalizeLines(s string) string {
+	var b strings.Builder
+	for _, line := range strings.Split(s, "\n") {
+		if n := normalizeWhitespace(line); n != "" {
+			b.WriteString(n)
+			b.WriteString("\n")
+		}
+	}
+
+	return b.String()
+}
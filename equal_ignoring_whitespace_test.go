@@ -0,0 +1,54 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import "testing"
+
+func TestEqualIgnoringWhitespace(t *testing.T) {
+	m := EqualIgnoringWhitespace("func foo() {\n\treturn\n}\n")
+	expectEqStr(
+		t,
+		`equal ignoring whitespace to "func foo() {\n\treturn\n}\n" `+
+			`(normalized: "func foo() { return }")`,
+		m.Description())
+
+	if err := m.Matches("  func foo()  {  return }"); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	err := m.Matches("func foo() { return nil }")
+	if err == nil {
+		t.Fatalf("Expected an error.")
+	}
+
+	expectEqStr(t, `which normalizes to "func foo() { return nil }"`, err.Error())
+}
+
+func TestEqualIgnoringWhitespaceDescribesMultiLineMismatch(t *testing.T) {
+	m := EqualIgnoringWhitespace("func foo() {\n\treturn\n}\n")
+
+	setUpCurrentTest()
+	ExpectThat("func foo() {\n  return nil\n}", m)
+
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+	expectEqStr(
+		t,
+		"Expected: "+m.Description()+"\n"+
+			"Actual:   func foo() {\n  return nil\n}, "+
+			`which normalizes to "func foo() { return nil }"; line by line:`+"\n"+
+			"--- want\n+++ got\n@@ -1,3 +1,3 @@\n func foo() {\n-return\n+return nil\n }\n",
+		currentlyRunningTest.failureRecords[0].Error)
+}
@@ -0,0 +1,188 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// EqualTo returns a matcher for values equal to x. It compares as ExpectEq
+// does, except that structs, arrays, slices, and maps are compared deeply
+// rather than with oglematchers.Equals, which doesn't support structs or
+// slices and compares maps by identity. It accepts options via WithOptions,
+// which apply to those deep comparisons.
+//
+// For example:
+//
+//     ExpectThat(user, WithOptions(EqualTo(want), IgnoreUnexportedFields()))
+//
+func EqualTo(x interface{}) oglematchers.Matcher {
+	return &equalToMatcher{x: x, wrapped: newEqualToMatcher(x, nil)}
+}
+
+// DeepEqualTo returns a matcher for values of the same type as x that are
+// deeply equal to it, in the sense of reflect.DeepEqual. It accepts options
+// via WithOptions. When the candidate differs, the mismatch names the first
+// differing field, element, or map entry.
+//
+// For example:
+//
+//     ExpectThat(
+//       got,
+//       WithOptions(DeepEqualTo(want), IgnoreFieldsOfType(time.Time{})))
+//
+func DeepEqualTo(x interface{}) oglematchers.Matcher {
+	return &deepEqualsMatcher{x: x}
+}
+
+// Return a matcher for values equal to x. If x's type has a method of the
+// form
+//
+//     func (T) Equal(T) bool
+//
+// as time.Time does, the matcher uses it to compare values of that type.
+// Otherwise it is oglematchers.Equals(x). This lets ExpectEq and friends
+// treat two time.Time values for the same instant as equal even when their
+// monotonic clock readings or locations differ.
+func equals(x interface{}) oglematchers.Matcher {
+	if m := equalMethodMatcherFor(x); m != nil {
+		return m
+	}
+
+	return oglematchers.Equals(x)
+}
+
+// Return a matcher that compares with x's Equal method, or nil if x's type
+// has no such method.
+func equalMethodMatcherFor(x interface{}) oglematchers.Matcher {
+	v := reflect.ValueOf(x)
+	if !v.IsValid() || !hasEqualMethod(v.Type()) {
+		return nil
+	}
+
+	// Calling the method on a nil pointer would likely panic.
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil
+	}
+
+	return &equalMethodMatcher{v}
+}
+
+// Choose the matcher used by EqualTo, passing the supplied options, which may
+// be nil, to the deep comparison.
+func newEqualToMatcher(x interface{}, opts *matchOptions) oglematchers.Matcher {
+	if m := equalMethodMatcherFor(x); m != nil {
+		return m
+	}
+
+	switch reflect.ValueOf(x).Kind() {
+	case reflect.Struct, reflect.Array, reflect.Slice, reflect.Map:
+		return &deepEqualsMatcher{x: x, opts: opts}
+	}
+
+	return oglematchers.Equals(x)
+}
+
+type equalToMatcher struct {
+	x       interface{}
+	wrapped oglematchers.Matcher
+}
+
+func (m *equalToMatcher) Description() string {
+	return m.wrapped.Description()
+}
+
+func (m *equalToMatcher) Matches(c interface{}) error {
+	return m.wrapped.Matches(c)
+}
+
+func (m *equalToMatcher) withOptions(o *matchOptions) oglematchers.Matcher {
+	return &equalToMatcher{x: m.x, wrapped: newEqualToMatcher(m.x, o)}
+}
+
+// Does t have a method Equal(t) bool?
+func hasEqualMethod(t reflect.Type) bool {
+	m, ok := t.MethodByName("Equal")
+	if !ok {
+		return false
+	}
+
+	// The method type includes the receiver.
+	mt := m.Type
+	return mt.NumIn() == 2 &&
+		mt.In(1) == t &&
+		mt.NumOut() == 1 &&
+		mt.Out(0).Kind() == reflect.Bool
+}
+
+type equalMethodMatcher struct {
+	expected reflect.Value
+}
+
+func (m *equalMethodMatcher) Description() string {
+	return fmt.Sprintf("%v", m.expected.Interface())
+}
+
+func (m *equalMethodMatcher) Matches(c interface{}) error {
+	t := m.expected.Type()
+	v := reflect.ValueOf(c)
+	if !v.IsValid() || v.Type() != t {
+		return oglematchers.NewFatalError(fmt.Sprintf("which is not a %v", t))
+	}
+
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return errors.New("")
+	}
+
+	out := m.expected.MethodByName("Equal").Call([]reflect.Value{v})
+	if !out[0].Bool() {
+		return errors.New("")
+	}
+
+	return nil
+}
+
+type deepEqualsMatcher struct {
+	x interface{}
+
+	// Options set with WithOptions, or nil.
+	opts *matchOptions
+}
+
+func (m *deepEqualsMatcher) Description() string {
+	return fmt.Sprintf("%v", m.x)
+}
+
+func (m *deepEqualsMatcher) Matches(c interface{}) error {
+	if reflect.TypeOf(c) != reflect.TypeOf(m.x) {
+		return oglematchers.NewFatalError(
+			fmt.Sprintf("which is not a %v", reflect.TypeOf(m.x)))
+	}
+
+	if diff := firstDifferenceWithOptions(m.x, c, m.opts); diff != "" {
+		return errors.New("which differs " + diff)
+	}
+
+	return nil
+}
+
+func (m *deepEqualsMatcher) withOptions(o *matchOptions) oglematchers.Matcher {
+	return &deepEqualsMatcher{x: m.x, opts: o}
+}
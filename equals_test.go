@@ -0,0 +1,110 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEqualsUsesEqualMethod(t *testing.T) {
+	// The same instant, with and without a monotonic clock reading, and in
+	// different locations. These are not == to each other.
+	t0 := time.Now()
+	stripped := t0.Round(0)
+	utc := t0.UTC()
+
+	cases := []struct {
+		expected  interface{}
+		candidate interface{}
+		match     bool
+		err       string
+	}{
+		{t0, stripped, true, ""},
+		{t0, utc, true, ""},
+		{stripped, t0, true, ""},
+		{t0, t0.Add(time.Nanosecond), false, ""},
+		{t0, "taco", false, "which is not a time.Time"},
+	}
+
+	for i, c := range cases {
+		err := equals(c.expected).Matches(c.candidate)
+		if c.match != (err == nil) {
+			t.Errorf("Case %d: expected match %v, got error %v", i, c.match, err)
+			continue
+		}
+
+		if err != nil && err.Error() != c.err {
+			t.Errorf("Case %d: expected %q, got %q", i, c.err, err.Error())
+		}
+	}
+}
+
+func TestExpectEqUsesEqualMethod(t *testing.T) {
+	setUpCurrentTest()
+
+	t0 := time.Now()
+	ExpectEq(t0, t0.Round(0))
+	assertEqInt(t, 0, len(currentlyRunningTest.failureRecords))
+
+	ExpectNe(t0, t0.Round(0))
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+}
+
+func TestExpectEqComparesMapsByIdentity(t *testing.T) {
+	setUpCurrentTest()
+
+	// As with oglematchers.Equals, distinct maps are not equal even when their
+	// contents are.
+	m1 := map[string]int{"taco": 1}
+	m2 := map[string]int{"taco": 1}
+
+	ExpectNe(m1, m2)
+	ExpectEq(m1, m1)
+	expectEqInt(t, 0, len(currentlyRunningTest.failureRecords))
+
+	ExpectEq(m1, m2)
+	expectEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+}
+
+func TestEqualToComparesCompositesDeeply(t *testing.T) {
+	type pair struct {
+		A int
+		B []string
+	}
+
+	// oglematchers.Equals doesn't support most of these kinds, and compares
+	// maps by identity.
+	cases := []struct {
+		expected  interface{}
+		candidate interface{}
+		match     bool
+	}{
+		{pair{1, []string{"taco"}}, pair{1, []string{"taco"}}, true},
+		{pair{1, []string{"taco"}}, pair{1, []string{"burrito"}}, false},
+		{[]int{1, 2}, []int{1, 2}, true},
+		{[]int{1, 2}, []int{1, 3}, false},
+		{map[string]int{"a": 1}, map[string]int{"a": 1}, true},
+		{[2]int{1, 2}, [2]int{2, 1}, false},
+	}
+
+	for i, c := range cases {
+		err := EqualTo(c.expected).Matches(c.candidate)
+		if c.match != (err == nil) {
+			t.Errorf("Case %d: expected match %v, got error %v", i, c.match, err)
+		}
+	}
+}
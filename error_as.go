@@ -0,0 +1,83 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// ErrorAs returns a matcher that matches errors e for which errors.As(e,
+// target) is true, i.e. errors with an error in their chain that is
+// assignable to the type pointed to by target. When the matcher matches, it
+// sets *target to that error so that the test can go on to inspect it.
+//
+// target must be a non-nil pointer to an interface type or to a type
+// implementing error.
+//
+// For example:
+//
+//     var pathErr *os.PathError
+//     AssertThat(err, ErrorAs(&pathErr))
+//     ExpectEq("/tmp/foo", pathErr.Path)
+//
+func ErrorAs(target interface{}) oglematchers.Matcher {
+	t := reflect.TypeOf(target)
+	if t == nil || t.Kind() != reflect.Ptr || reflect.ValueOf(target).IsNil() {
+		panic("ErrorAs: target must be a non-nil pointer.")
+	}
+
+	elem := t.Elem()
+	if elem.Kind() != reflect.Interface && !elem.Implements(errorType) {
+		panic(fmt.Sprintf(
+			"ErrorAs: *target must be an interface or implement error, not %v",
+			elem))
+	}
+
+	return &errorAsMatcher{target}
+}
+
+type errorAsMatcher struct {
+	target interface{}
+}
+
+func (m *errorAsMatcher) Description() string {
+	return fmt.Sprintf(
+		"error with %v in its chain",
+		reflect.TypeOf(m.target).Elem())
+}
+
+func (m *errorAsMatcher) Matches(c interface{}) error {
+	if c == nil {
+		return errors.New("which is nil")
+	}
+
+	err, ok := c.(error)
+	if !ok {
+		return oglematchers.NewFatalError("which is not an error")
+	}
+
+	if errors.As(err, m.target) {
+		return nil
+	}
+
+	return errors.New(fmt.Sprintf("whose chain is %s", describeErrorChain(err)))
+}
@@ -0,0 +1,45 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"fmt"
+	"io"
+	"testing"
+)
+
+type fakeError struct {
+	code int
+}
+
+func (e *fakeError) Error() string {
+	return fmt.Sprintf("code %d", e.code)
+}
+
+func TestErrorAs(t *testing.T) {
+	var target *fakeError
+	m := ErrorAs(&target)
+
+	if err := m.Matches(fmt.Errorf("calling: %w", &fakeError{17})); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectEqInt(t, 17, target.code)
+
+	if err := m.Matches(io.EOF); err == nil {
+		t.Errorf("Expected an error.")
+	}
+}
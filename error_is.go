@@ -0,0 +1,72 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// ErrorIs returns a matcher that matches errors e for which errors.Is(e,
+// target) is true, i.e. errors that are or that wrap target.
+//
+// For example:
+//
+//     _, err := io.ReadFull(r, buf)
+//     ExpectThat(err, ErrorIs(io.ErrUnexpectedEOF))
+//
+func ErrorIs(target error) oglematchers.Matcher {
+	return &errorIsMatcher{target}
+}
+
+type errorIsMatcher struct {
+	target error
+}
+
+func (m *errorIsMatcher) Description() string {
+	return fmt.Sprintf("error that is or wraps %q", m.target)
+}
+
+func (m *errorIsMatcher) Matches(c interface{}) error {
+	if c == nil {
+		return errors.New("which is nil")
+	}
+
+	err, ok := c.(error)
+	if !ok {
+		return oglematchers.NewFatalError("which is not an error")
+	}
+
+	if errors.Is(err, m.target) {
+		return nil
+	}
+
+	return errors.New(fmt.Sprintf("whose chain is %s", describeErrorChain(err)))
+}
+
+// Return a description of err and the errors it wraps, as revealed by
+// errors.Unwrap.
+func describeErrorChain(err error) string {
+	var parts []string
+	for ; err != nil; err = errors.Unwrap(err) {
+		parts = append(parts, fmt.Sprintf("%q", err))
+	}
+
+	return strings.Join(parts, " -> ")
+}
@@ -0,0 +1,51 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+func TestErrorIs(t *testing.T) {
+	m := ErrorIs(io.EOF)
+	wrapped := fmt.Errorf("reading: %w", io.EOF)
+
+	if err := m.Matches(wrapped); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	err := m.Matches(fmt.Errorf("reading: %w", io.ErrClosedPipe))
+	if err == nil {
+		t.Fatalf("Expected an error.")
+	}
+
+	expectEqStr(
+		t,
+		`whose chain is "reading: io: read/write on closed pipe" -> `+
+			`"io: read/write on closed pipe"`,
+		err.Error())
+}
+
+func TestErrorIsNonError(t *testing.T) {
+	err := ErrorIs(io.EOF).Matches(17)
+	if _, ok := err.(*oglematchers.FatalError); !ok {
+		t.Errorf("Expected a fatal error, got %v", err)
+	}
+}
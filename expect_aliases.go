@@ -18,16 +18,19 @@ package ogletest
 import "github.com/jacobsa/oglematchers"
 
 // ExpectEq(e, a) is equivalent to ExpectThat(a, oglematchers.Equals(e)).
+// If the type of e has a method Equal(T) bool, as time.Time does, it is used
+// instead.
 func ExpectEq(expected, actual interface{}, errorParts ...interface{}) {
-	expectThat(actual, oglematchers.Equals(expected), 1, errorParts)
+	expectThat(actual, equals(expected), 1, errorParts)
 }
 
 // ExpectNe(e, a) is equivalent to
 // ExpectThat(a, oglematchers.Not(oglematchers.Equals(e))).
+// As with ExpectEq, an Equal method on the type of e is used if present.
 func ExpectNe(expected, actual interface{}, errorParts ...interface{}) {
 	expectThat(
 		actual,
-		oglematchers.Not(oglematchers.Equals(expected)),
+		oglematchers.Not(equals(expected)),
 		1,
 		errorParts)
 }
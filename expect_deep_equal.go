@@ -0,0 +1,43 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ExpectDeepEqual is like ExpectEqual, but compares want and got using
+// reflect.DeepEqual, so it may be used with any type, including those that
+// don't support ==. When they differ, the failure message points out the
+// first differing field, element, or map entry.
+//
+// For example:
+//
+//     ExpectDeepEqual([]string{"taco", "burrito"}, menu.Items())
+//
+func ExpectDeepEqual[T any](want, got T, errorParts ...interface{}) {
+	if reflect.DeepEqual(want, got) {
+		return
+	}
+
+	msg := fmt.Sprintf("want: %+v\ngot:  %+v", want, got)
+	if diff := firstDifference(want, got); diff != "" {
+		msg = fmt.Sprintf("%s\nfirst difference %s", msg, diff)
+	}
+
+	recordFailure(1, msg, errorParts)
+}
@@ -0,0 +1,35 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import "testing"
+
+func TestExpectDeepEqual(t *testing.T) {
+	setUpCurrentTest()
+	ExpectDeepEqual([]string{"taco"}, []string{"taco"})
+	ExpectDeepEqual([]string{"taco", "burrito"}, []string{"taco", "enchilada"})
+
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+	record := currentlyRunningTest.failureRecords[0]
+
+	expectEqStr(t, "expect_deep_equal_test.go", record.FileName)
+	expectEqStr(
+		t,
+		"want: [taco burrito]\n"+
+			"got:  [taco enchilada]\n"+
+			"first difference at got[1]: want burrito, got enchilada",
+		record.Error)
+}
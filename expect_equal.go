@@ -0,0 +1,38 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import "fmt"
+
+// ExpectEqual is a type-safe alternative to ExpectEq. It compares want and got
+// using ==, so unlike ExpectEq it cannot be fooled by values of different
+// types, and passing them is a compile-time error. Extra parameters are
+// treated as in ExpectThat.
+//
+// For example:
+//
+//     ExpectEqual(time.Second, timeout)
+//     ExpectEqual("taco", order.Item, "for order %d", order.ID)
+//
+func ExpectEqual[T comparable](want, got T, errorParts ...interface{}) {
+	if want == got {
+		return
+	}
+
+	// %v prefers String methods, and copes with nil receivers that panic.
+	msg := fmt.Sprintf("want: %v, got: %v", want, got)
+	recordFailure(1, msg, errorParts)
+}
@@ -0,0 +1,60 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+type stringerValue int
+
+func (v stringerValue) String() string {
+	return fmt.Sprintf("value #%d", int(v))
+}
+
+type ptrStringer struct{ name string }
+
+func (p *ptrStringer) String() string {
+	return p.name
+}
+
+func TestExpectEqual(t *testing.T) {
+	setUpCurrentTest()
+	ExpectEqual(17, 17)
+	ExpectEqual(17, 19, "taco")
+	ExpectEqual(stringerValue(1), stringerValue(2))
+	ExpectEqual(time.Second, 2*time.Second)
+
+	records := currentlyRunningTest.failureRecords
+	assertEqInt(t, 3, len(records))
+
+	expectEqStr(t, "expect_equal_test.go", records[0].FileName)
+	expectEqStr(t, "want: 17, got: 19\ntaco", records[0].Error)
+	expectEqStr(t, "want: value #1, got: value #2", records[1].Error)
+	expectEqStr(t, "want: 1s, got: 2s", records[2].Error)
+}
+
+func TestExpectEqualNilStringer(t *testing.T) {
+	setUpCurrentTest()
+	ExpectEqual(&ptrStringer{"taco"}, (*ptrStringer)(nil))
+
+	records := currentlyRunningTest.failureRecords
+	assertEqInt(t, 1, len(records))
+
+	expectEqStr(t, "want: taco, got: <nil>", records[0].Error)
+}
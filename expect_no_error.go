@@ -0,0 +1,57 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import "fmt"
+
+// ExpectNoError calls f and confirms that it returns a nil error, adding a
+// failure record to the currently running test if it does not. Extra
+// parameters are treated as in ExpectThat.
+//
+// For example:
+//
+//     ExpectNoError(db.Ping)
+//     ExpectNoError(func() error { return os.Remove(path) })
+//
+func ExpectNoError(f func() error, errorParts ...interface{}) {
+	expectNoError(f, 1, errorParts)
+}
+
+// AssertNoError is identical to ExpectNoError, except that in the event of
+// failure it halts the currently running test immediately.
+func AssertNoError(f func() error, errorParts ...interface{}) {
+	if !expectNoError(f, 1, errorParts) {
+		AbortTest()
+	}
+}
+
+// The generalized form of ExpectNoError. depth is the distance on the stack
+// between the caller's frame and the user's frame. Returns passed iff f
+// returned nil.
+func expectNoError(
+	f func() error,
+	depth int,
+	errorParts []interface{}) (passed bool) {
+	err := f()
+	if err == nil {
+		passed = true
+		return
+	}
+
+	msg := fmt.Sprintf("Expected: no error\nActual:   %v", err)
+	recordFailure(depth+1, msg, errorParts)
+	return
+}
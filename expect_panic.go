@@ -0,0 +1,110 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"fmt"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// ExpectPanic calls f and confirms that it panics with a value matched by m,
+// adding a failure record to the currently running test if it does not. Extra
+// parameters are treated as in ExpectThat.
+//
+// For example:
+//
+//     ExpectPanic(func() { parseOrDie("") }, HasSubstr("empty input"))
+//
+func ExpectPanic(
+	f func(),
+	m oglematchers.Matcher,
+	errorParts ...interface{}) {
+	expectPanic(f, m, 1, errorParts)
+}
+
+// AssertPanic is identical to ExpectPanic, except that in the event of
+// failure it halts the currently running test immediately.
+func AssertPanic(
+	f func(),
+	m oglematchers.Matcher,
+	errorParts ...interface{}) {
+	if !expectPanic(f, m, 1, errorParts) {
+		AbortTest()
+	}
+}
+
+// InterceptPanics calls f, returning whether it panicked and if so the value
+// it panicked with. Unlike ExpectPanic, it records no failures; it is meant
+// for helper code that wants to inspect a panic itself. A failed AssertThat
+// (or other call to AbortTest) within f is not intercepted, and still halts
+// the test.
+//
+// For example:
+//
+//     panicked, v := InterceptPanics(func() { m.Lock(); m.Lock() })
+//
+func InterceptPanics(f func()) (panicked bool, value interface{}) {
+	panicked = true
+	defer func() {
+		if !panicked {
+			return
+		}
+
+		value = recover()
+		if isAbortError(value) || isFailurePanic(value) {
+			panic(value)
+		}
+	}()
+
+	f()
+	panicked = false
+	return
+}
+
+// The generalized form of ExpectPanic. depth is the distance on the stack
+// between the caller's frame and the user's frame. Returns passed iff f
+// panicked with a matching value.
+func expectPanic(
+	f func(),
+	m oglematchers.Matcher,
+	depth int,
+	errorParts []interface{}) (passed bool) {
+	panicked, value := InterceptPanics(f)
+	if !panicked {
+		msg := fmt.Sprintf(
+			"Expected: panic with %s\nActual:   function returned normally",
+			m.Description())
+
+		recordFailure(depth+1, msg, errorParts)
+		return
+	}
+
+	matcherErr := m.Matches(value)
+	if matcherErr == nil {
+		passed = true
+		return
+	}
+
+	msg := fmt.Sprintf(
+		"Expected: panic with %s\nActual:   panic with %v%s",
+		m.Description(),
+		value,
+		relativeClause(m, value, matcherErr))
+
+	recordFailure(depth+1, msg, errorParts)
+	return
+}
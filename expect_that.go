@@ -24,6 +24,18 @@ import (
 	"github.com/jacobsa/oglematchers"
 )
 
+// Matchers may optionally implement this interface in order to provide a
+// richer explanation of a mismatch than the error returned by Matches. This
+// allows expensive work like computing a diff to be deferred until a failure
+// is actually being reported.
+type MismatchDescriber interface {
+	// Return a description of why the candidate x doesn't match, in the same
+	// form as the error text returned by Matches (e.g. "which is not a
+	// string"). This will be called only after Matches has returned an error
+	// for x.
+	DescribeMismatch(x interface{}) string
+}
+
 // ExpectThat confirms that the supplied matcher matches the value x, adding a
 // failure record to the currently running test if it does not. If additional
 // parameters are supplied, the first will be used as a format string for the
@@ -57,28 +69,65 @@ func expectThat(
 		return
 	}
 
-	var r FailureRecord
+	// Create an appropriate failure message. Make sure that the expected and
+	// actual values align properly.
+	msg := fmt.Sprintf(
+		"Expected: %s\nActual:   %v%s",
+		m.Description(),
+		x,
+		relativeClause(m, x, matcherErr))
+
+	// Report the failure.
+	recordTaggedFailure(depth+1, msg, failureTags(matcherErr), errorParts)
+
+	return
+}
+
+// Return the clause to be appended to the description of a candidate x that
+// m failed to match with the supplied error, including a leading comma, or
+// the empty string if there is nothing to say.
+func relativeClause(
+	m oglematchers.Matcher,
+	x interface{},
+	matcherErr error) string {
+	text := matcherErr.Error()
+	if d, ok := m.(MismatchDescriber); ok {
+		text = d.DescribeMismatch(x)
+	}
+
+	if text == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(", %s", text)
+}
+
+// Add a failure record with the supplied message for the user's frame, which
+// is depth frames above the caller of this function. The user error
+// described by errorParts, if any, is appended to the message.
+func recordFailure(
+	depth int,
+	msg string,
+	errorParts []interface{}) {
+	recordTaggedFailure(depth+1, msg, nil, errorParts)
+}
+
+// Like recordFailure, but attach the supplied tags to the record.
+func recordTaggedFailure(
+	depth int,
+	msg string,
+	tags map[string]string,
+	errorParts []interface{}) {
+	r := FailureRecord{Tags: tags}
 
 	// Get information about the call site.
 	var ok bool
 	if _, r.FileName, r.LineNumber, ok = runtime.Caller(depth + 1); !ok {
-		panic("expectThat: runtime.Caller")
+		panic("recordFailure: runtime.Caller")
 	}
 
 	r.FileName = path.Base(r.FileName)
-
-	// Create an appropriate failure message. Make sure that the expected and
-	// actual values align properly.
-	relativeClause := ""
-	if matcherErr.Error() != "" {
-		relativeClause = fmt.Sprintf(", %s", matcherErr.Error())
-	}
-
-	r.Error = fmt.Sprintf(
-		"Expected: %s\nActual:   %v%s",
-		m.Description(),
-		x,
-		relativeClause)
+	r.Error = msg
 
 	// Add the user error, if any.
 	if len(errorParts) != 0 {
@@ -95,6 +144,4 @@ func expectThat(
 
 	// Report the failure.
 	AddFailureRecord(r)
-
-	return
 }
@@ -0,0 +1,85 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// How long ExpectThatEventually waits between calls to the user's function.
+const eventuallyPollInterval = 10 * time.Millisecond
+
+// ExpectThatEventually repeatedly calls fn until it returns a value matched by
+// m, adding a failure record to the currently running test if that doesn't
+// happen within the supplied timeout. The failure message describes the last
+// value returned by fn. Extra parameters are treated as in ExpectThat.
+//
+// For example:
+//
+//     ExpectThatEventually(
+//       func() interface{} { return server.ConnectionCount() },
+//       Equals(3),
+//       time.Second)
+//
+func ExpectThatEventually(
+	fn func() interface{},
+	m oglematchers.Matcher,
+	timeout time.Duration,
+	errorParts ...interface{}) {
+	expectThatEventually(fn, m, timeout, 1, errorParts)
+}
+
+// The generalized form of ExpectThatEventually. depth is the distance on the
+// stack between the caller's frame and the user's frame. Returns passed iff
+// the match eventually succeeded.
+func expectThatEventually(
+	fn func() interface{},
+	m oglematchers.Matcher,
+	timeout time.Duration,
+	depth int,
+	errorParts []interface{}) (passed bool) {
+	deadline := time.Now().Add(timeout)
+
+	// Always call fn at least once, even if the timeout is zero.
+	var x interface{}
+	var matcherErr error
+	for {
+		x = fn()
+		if matcherErr = m.Matches(x); matcherErr == nil {
+			passed = true
+			return
+		}
+
+		if !time.Now().Before(deadline) {
+			break
+		}
+
+		time.Sleep(eventuallyPollInterval)
+	}
+
+	msg := fmt.Sprintf(
+		"After %v, got %v%s, expected %s",
+		timeout,
+		x,
+		relativeClause(m, x, matcherErr),
+		m.Description())
+
+	recordFailure(depth+1, msg, errorParts)
+	return
+}
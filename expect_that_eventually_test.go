@@ -0,0 +1,52 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/jacobsa/oglematchers"
+)
+
+func TestExpectThatEventually(t *testing.T) {
+	setUpCurrentTest()
+
+	// A value that becomes correct after a few calls.
+	n := 0
+	ExpectThatEventually(
+		func() interface{} { n++; return n },
+		Equals(3),
+		time.Second)
+
+	assertEqInt(t, 0, len(currentlyRunningTest.failureRecords))
+
+	// A value that never becomes correct.
+	ExpectThatEventually(
+		func() interface{} { return 17 },
+		&fakeExpectThatMatcher{"taco", errors.New("which is foo")},
+		20*time.Millisecond)
+
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+	record := currentlyRunningTest.failureRecords[0]
+
+	expectEqStr(t, "expect_that_eventually_test.go", record.FileName)
+	expectEqStr(
+		t,
+		"After 20ms, got 17, which is foo, expected taco",
+		record.Error)
+}
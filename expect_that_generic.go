@@ -0,0 +1,39 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import "github.com/jacobsa/oglematchers"
+
+// ExpectThatG is a generic variant of ExpectThat. The type of x is fixed at
+// compile time, so for example passing a value of the wrong type to a helper
+// that forwards it here is caught by the compiler rather than being silently
+// converted to interface{}.
+//
+// Only the compile-time check differs. The matcher still receives x as an
+// interface{}, and so sees its dynamic type; in particular, a nil value of an
+// interface type T reaches it as a plain nil. Failures are reported exactly as
+// by ExpectThat.
+//
+// For example:
+//
+//     ExpectThatG[time.Duration](elapsed, LessThan(time.Second))
+//
+func ExpectThatG[T any](
+	x T,
+	m oglematchers.Matcher,
+	errorParts ...interface{}) {
+	expectThat(x, m, 1, errorParts)
+}
@@ -0,0 +1,67 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// A matcher that records the candidates it is given.
+type recordingMatcher struct {
+	fakeExpectThatMatcher
+	candidates []interface{}
+}
+
+func (m *recordingMatcher) Matches(c interface{}) error {
+	m.candidates = append(m.candidates, c)
+	return m.fakeExpectThatMatcher.Matches(c)
+}
+
+func TestExpectThatG(t *testing.T) {
+	setUpCurrentTest()
+
+	m := &recordingMatcher{fakeExpectThatMatcher: fakeExpectThatMatcher{"taco", nil}}
+	ExpectThatG[time.Duration](time.Second, m)
+	ExpectThatG[error](nil, m)
+
+	assertEqInt(t, 0, len(currentlyRunningTest.failureRecords))
+
+	// The matcher sees the dynamic type of the value, and a nil interface
+	// value as a plain nil.
+	assertEqInt(t, 2, len(m.candidates))
+	if d, ok := m.candidates[0].(time.Duration); !ok || d != time.Second {
+		t.Errorf("Unexpected candidate: %#v", m.candidates[0])
+	}
+
+	if m.candidates[1] != nil {
+		t.Errorf("Unexpected candidate: %#v", m.candidates[1])
+	}
+
+	// Failures are reported as by ExpectThat.
+	m.err = errors.New("which is not a taco")
+	ExpectThatG(17, m, "burrito %d", 19)
+
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+	record := currentlyRunningTest.failureRecords[0]
+
+	expectEqStr(t, "expect_that_generic_test.go", record.FileName)
+	expectEqStr(
+		t,
+		"Expected: taco\nActual:   17, which is not a taco\nburrito 19",
+		record.Error)
+}
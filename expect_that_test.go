@@ -166,3 +166,137 @@ func TestAdditionalFailure(t *testing.T) {
 	expectEqStr(t, "Expected: \nActual:   17\ntaco", record1.Error)
 	expectEqStr(t, "Expected: \nActual:   19\nburrito", record2.Error)
 }
+
+func TestIdenticalFailuresAreFolded(t *testing.T) {
+	setUpCurrentTest()
+	matcher := &fakeExpectThatMatcher{"", errors.New("")}
+
+	// Fail identically several times.
+	for i := 0; i < 3; i++ {
+		ExpectThat(17, matcher)
+	}
+
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+	expectEqInt(t, 2, currentlyRunningTest.failureRecords[0].repeats)
+}
+
+func TestDeduplicationDisabled(t *testing.T) {
+	*fNoDedup = true
+	defer func() { *fNoDedup = false }()
+
+	setUpCurrentTest()
+	matcher := &fakeExpectThatMatcher{"", errors.New("")}
+
+	for i := 0; i < 3; i++ {
+		ExpectThat(17, matcher)
+	}
+
+	assertEqInt(t, 3, len(currentlyRunningTest.failureRecords))
+}
+
+func TestExpectPanicReturnsNormally(t *testing.T) {
+	setUpCurrentTest()
+	matcher := &fakeExpectThatMatcher{"taco", nil}
+	ExpectPanic(func() {}, matcher)
+
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+	record := currentlyRunningTest.failureRecords[0]
+
+	expectEqStr(t, "expect_that_test.go", record.FileName)
+	expectEqStr(
+		t,
+		"Expected: panic with taco\nActual:   function returned normally",
+		record.Error)
+}
+
+func TestExpectPanicWrongValue(t *testing.T) {
+	setUpCurrentTest()
+	matcher := &fakeExpectThatMatcher{"taco", errors.New("which is foo")}
+	ExpectPanic(func() { panic(17) }, matcher)
+
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+	record := currentlyRunningTest.failureRecords[0]
+
+	expectEqStr(
+		t,
+		"Expected: panic with taco\nActual:   panic with 17, which is foo",
+		record.Error)
+}
+
+func TestExpectPanicMatchingValue(t *testing.T) {
+	setUpCurrentTest()
+	matcher := &fakeExpectThatMatcher{"taco", nil}
+	ExpectPanic(func() { panic(17) }, matcher)
+
+	assertEqInt(t, 0, len(currentlyRunningTest.failureRecords))
+}
+
+type fakeMismatchDescriber struct {
+	fakeExpectThatMatcher
+}
+
+func (m *fakeMismatchDescriber) DescribeMismatch(c interface{}) string {
+	return "which is described"
+}
+
+func TestMismatchDescriber(t *testing.T) {
+	setUpCurrentTest()
+	matcher := &fakeMismatchDescriber{
+		fakeExpectThatMatcher{"taco", errors.New("which is foo")},
+	}
+
+	ExpectThat(17, matcher)
+
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+	record := currentlyRunningTest.failureRecords[0]
+	expectEqStr(t, "Expected: taco\nActual:   17, which is described", record.Error)
+}
+
+func TestExpectNoError(t *testing.T) {
+	setUpCurrentTest()
+	ExpectNoError(func() error { return nil })
+	ExpectNoError(func() error { return errors.New("taco") })
+
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+	record := currentlyRunningTest.failureRecords[0]
+
+	expectEqStr(t, "expect_that_test.go", record.FileName)
+	expectEqStr(t, "Expected: no error\nActual:   taco", record.Error)
+}
+
+func TestGetFailureRecords(t *testing.T) {
+	setUpCurrentTest()
+	if HasFailures() {
+		t.Errorf("Expected no failures.")
+	}
+
+	AddFailure("taco")
+	records := GetFailureRecords()
+
+	assertEqInt(t, 1, len(records))
+	expectEqStr(t, "taco", records[0].Error)
+
+	if !HasFailures() {
+		t.Errorf("Expected failures.")
+	}
+
+	// The result should be a copy.
+	records[0].Error = "burrito"
+	expectEqStr(t, "taco", currentlyRunningTest.failureRecords[0].Error)
+}
+
+func TestInterceptPanics(t *testing.T) {
+	setUpCurrentTest()
+
+	panicked, value := InterceptPanics(func() { panic("taco") })
+	if !panicked || value != "taco" {
+		t.Errorf("Unexpected result: %v, %v", panicked, value)
+	}
+
+	panicked, value = InterceptPanics(func() {})
+	if panicked || value != nil {
+		t.Errorf("Unexpected result: %v, %v", panicked, value)
+	}
+
+	assertEqInt(t, 0, len(currentlyRunningTest.failureRecords))
+}
@@ -16,6 +16,7 @@
 package ogletest
 
 import (
+	"errors"
 	"fmt"
 	"path"
 	"runtime"
@@ -42,6 +43,47 @@ type FailureRecord struct {
 	//     Actual:   "taco", which is not numeric
 	//
 	Error string
+
+	// Optional machine-readable metadata about the failure, such as
+	// {"category": "timeout"}, for the benefit of tools that process failures.
+	// Set from the matcher's error when it implements TaggedError, or directly
+	// by callers of AddFailureRecord. Printed after the error.
+	Tags map[string]string
+
+	// The number of further identical failures that were folded into this
+	// record, when deduplication is enabled.
+	repeats int
+}
+
+// TaggedError may be implemented by the errors that matchers return from their
+// Matches methods, in order to attach tags to the failure records created by
+// ExpectThat and friends when they don't match.
+//
+// For example:
+//
+//     type timeoutError struct{ d time.Duration }
+//
+//     func (e *timeoutError) Error() string {
+//       return fmt.Sprintf("which timed out after %v", e.d)
+//     }
+//
+//     func (e *timeoutError) Tags() map[string]string {
+//       return map[string]string{"category": "timeout"}
+//     }
+//
+type TaggedError interface {
+	error
+	Tags() map[string]string
+}
+
+// Return the tags attached to err or any error it wraps, or nil if none.
+func failureTags(err error) map[string]string {
+	var tagged TaggedError
+	if errors.As(err, &tagged) {
+		return tagged.Tags()
+	}
+
+	return nil
 }
 
 // Record a failure for the currently running test (and continue running it).
@@ -52,9 +94,48 @@ func AddFailureRecord(r FailureRecord) {
 	currentlyRunningTest.mu.Lock()
 	defer currentlyRunningTest.mu.Unlock()
 
-	currentlyRunningTest.failureRecords = append(
-		currentlyRunningTest.failureRecords,
-		r)
+	currentlyRunningTest.addFailureRecord(r)
+}
+
+// GetFailureRecords returns a copy of the failure records added so far by the
+// currently running test.
+func GetFailureRecords() []FailureRecord {
+	currentlyRunningTest.mu.RLock()
+	defer currentlyRunningTest.mu.RUnlock()
+
+	records := make([]FailureRecord, len(currentlyRunningTest.failureRecords))
+	copy(records, currentlyRunningTest.failureRecords)
+
+	return records
+}
+
+// HasFailures returns true iff the currently running test has recorded any
+// failures so far. It is equivalent to len(GetFailureRecords()) > 0.
+func HasFailures() bool {
+	return len(GetFailureRecords()) > 0
+}
+
+// IsFailed returns true iff the currently running test has recorded any
+// failures so far. It is a synonym for HasFailures.
+func IsFailed() bool {
+	return HasFailures()
+}
+
+// AbortIfFailed halts the currently running test immediately if it has
+// recorded any failures so far, as if an AssertThat call had failed. This
+// gives tests with many ExpectThat calls a way to avoid piling up noise once
+// something has gone wrong.
+//
+// For example:
+//
+//     ExpectEq(200, resp.StatusCode)
+//     ExpectEq("application/json", resp.Header.Get("Content-Type"))
+//     AbortIfFailed()  // No point decoding the body.
+//
+func AbortIfFailed() {
+	if IsFailed() {
+		AbortTest()
+	}
 }
 
 // Call AddFailureRecord with a record whose file name and line number come
@@ -82,6 +163,21 @@ func AddFailure(format string, a ...interface{}) {
 type abortError struct {
 }
 
+// The value given to panic() when a failure is recorded and the user has asked
+// for --ogletest.panic-on-failure. Unlike other panics, runTests doesn't
+// recover from these, so that the program crashes at the failure site.
+type failurePanic struct {
+	Record FailureRecord
+}
+
+func (p failurePanic) String() string {
+	return fmt.Sprintf(
+		"ogletest failure at %s:%d:\n%s",
+		p.Record.FileName,
+		p.Record.LineNumber,
+		p.Record.Error)
+}
+
 // Immediately stop executing the running test, causing it to fail with the
 // failures previously recorded. Behavior is undefined if no failures have been
 // recorded.
@@ -0,0 +1,97 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"fmt"
+	"testing"
+)
+
+type taggedMatcherError struct{}
+
+func (e *taggedMatcherError) Error() string {
+	return "which timed out"
+}
+
+func (e *taggedMatcherError) Tags() map[string]string {
+	return map[string]string{"severity": "critical", "category": "timeout"}
+}
+
+func TestFailureTagsFromMatcher(t *testing.T) {
+	setUpCurrentTest()
+
+	// Tags should be found even on wrapped errors.
+	ExpectThat(17, &fakeExpectThatMatcher{
+		"taco",
+		fmt.Errorf("wrapped: %w", &taggedMatcherError{}),
+	})
+
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+	record := currentlyRunningTest.failureRecords[0]
+
+	expectEqStr(t, "timeout", record.Tags["category"])
+	expectEqStr(t, "critical", record.Tags["severity"])
+
+	// Other failures have no tags.
+	AddFailure("taco")
+	assertEqInt(t, 2, len(currentlyRunningTest.failureRecords))
+	expectEqInt(t, 0, len(currentlyRunningTest.failureRecords[1].Tags))
+}
+
+func TestPrintFailuresShowsTags(t *testing.T) {
+	stdout, _ := CaptureOutput(func() {
+		printFailures([]FailureRecord{
+			{
+				FileName:   "foo_test.go",
+				LineNumber: 17,
+				Error:      "taco",
+				Tags:       (&taggedMatcherError{}).Tags(),
+			},
+		})
+	})
+
+	expectEqStr(
+		t,
+		"foo_test.go:17:\ntaco\n(Tags: category=timeout, severity=critical)\n\n",
+		stdout)
+}
+
+func TestIsFailedAndAbortIfFailed(t *testing.T) {
+	setUpCurrentTest()
+
+	// Before any failure, AbortIfFailed does nothing.
+	if IsFailed() {
+		t.Errorf("Expected IsFailed to be false.")
+	}
+
+	AbortIfFailed()
+
+	ExpectEq(17, 19)
+	if !IsFailed() {
+		t.Errorf("Expected IsFailed to be true.")
+	}
+
+	// Afterward, it aborts the test.
+	var r interface{}
+	func() {
+		defer func() { r = recover() }()
+		AbortIfFailed()
+	}()
+
+	if !isAbortError(r) {
+		t.Errorf("Expected an abortError, got %#v", r)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"sync"
+	"time"
+)
+
+// A FakeClock is a clock whose time changes only when told to, for use in
+// place of time.Now in code under test. It is safe for concurrent use.
+//
+// ogletest never installs a fake clock itself; inject the clock's Now method
+// where needed. For example:
+//
+//     clock := FakeTime(time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC))
+//     cache := NewCache(clock.Now)
+//
+//     clock.Advance(time.Hour)
+//     ExpectTrue(cache.IsStale())
+//
+type FakeClock struct {
+	mu sync.Mutex
+
+	// GUARDED_BY(mu)
+	now time.Time
+}
+
+// FakeTime returns a fake clock whose current time is initial.
+func FakeTime(initial time.Time) *FakeClock {
+	return &FakeClock{now: initial}
+}
+
+// Now returns the clock's current time.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// Advance moves the clock's current time forward by d.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+// Set changes the clock's current time to t.
+func (c *FakeClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = t
+}
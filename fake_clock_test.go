@@ -0,0 +1,41 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := FakeTime(start)
+
+	if !clock.Now().Equal(start) {
+		t.Errorf("Unexpected time: %v", clock.Now())
+	}
+
+	clock.Advance(time.Hour)
+	if !clock.Now().Equal(start.Add(time.Hour)) {
+		t.Errorf("Unexpected time: %v", clock.Now())
+	}
+
+	later := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock.Set(later)
+	if !clock.Now().Equal(later) {
+		t.Errorf("Unexpected time: %v", clock.Now())
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// FSMatches returns a matcher for fs.FS values that matches file systems
+// containing exactly the regular files in expected, with the same contents.
+// Directories are not compared, except as implied by the files within them.
+//
+// For example:
+//
+//     ExpectThat(os.DirFS(outputDir), FSMatches(fstest.MapFS{
+//       "index.html":    {Data: []byte("<h1>Tacos</h1>")},
+//       "css/style.css": {Data: []byte("h1 { color: red }")},
+//     }))
+//
+func FSMatches(expected fs.FS) oglematchers.Matcher {
+	return &fsMatcher{expected}
+}
+
+type fsMatcher struct {
+	expected fs.FS
+}
+
+func (m *fsMatcher) Description() string {
+	files, err := readAllFiles(m.expected)
+	if err != nil {
+		return fmt.Sprintf("file system (unreadable: %v)", err)
+	}
+
+	return fmt.Sprintf("file system containing %s", formatPaths(files))
+}
+
+func (m *fsMatcher) Matches(c interface{}) error {
+	candidate, ok := c.(fs.FS)
+	if !ok {
+		return oglematchers.NewFatalError("which is not an fs.FS")
+	}
+
+	expected, err := readAllFiles(m.expected)
+	if err != nil {
+		return oglematchers.NewFatalError(
+			fmt.Sprintf("which couldn't be compared: expected: %v", err))
+	}
+
+	actual, err := readAllFiles(candidate)
+	if err != nil {
+		return oglematchers.NewFatalError(fmt.Sprintf("which is unreadable: %v", err))
+	}
+
+	// Sort the files into missing, unexpected, and differing.
+	var missing, unexpected, differing []string
+	for p, want := range expected {
+		got, ok := actual[p]
+		switch {
+		case !ok:
+			missing = append(missing, p)
+
+		case !bytes.Equal(got, want):
+			differing = append(differing, p)
+		}
+	}
+
+	for p := range actual {
+		if _, ok := expected[p]; !ok {
+			unexpected = append(unexpected, p)
+		}
+	}
+
+	var problems []string
+	if len(missing) != 0 {
+		sort.Strings(missing)
+		problems = append(
+			problems,
+			"which is missing "+strings.Join(missing, ", "))
+	}
+
+	if len(unexpected) != 0 {
+		sort.Strings(unexpected)
+		problems = append(
+			problems,
+			"which has unexpected files "+strings.Join(unexpected, ", "))
+	}
+
+	if len(differing) != 0 {
+		sort.Strings(differing)
+		problems = append(
+			problems,
+			"which has different contents in "+strings.Join(differing, ", "))
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(problems, "; "))
+}
+
+// Read the contents of every regular file in fsys, keyed by path.
+func readAllFiles(fsys fs.FS) (files map[string][]byte, err error) {
+	files = make(map[string][]byte)
+	err = fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		contents, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return err
+		}
+
+		files[p] = contents
+		return nil
+	})
+
+	return
+}
+
+// Return the sorted keys of the supplied map, formatted as a list.
+func formatPaths(files map[string][]byte) string {
+	if len(files) == 0 {
+		return "no files"
+	}
+
+	var paths []string
+	for p := range files {
+		paths = append(paths, p)
+	}
+
+	sort.Strings(paths)
+	return "[" + strings.Join(paths, ", ") + "]"
+}
@@ -0,0 +1,79 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"io/fs"
+	"testing"
+	"testing/fstest"
+)
+
+func TestFSMatches(t *testing.T) {
+	expected := fstest.MapFS{
+		"taco.txt":         {Data: []byte("taco")},
+		"burrito/beef.txt": {Data: []byte("beef")},
+		"burrito/bean.txt": {Data: []byte("bean")},
+	}
+
+	m := FSMatches(expected)
+	expectEqStr(
+		t,
+		"file system containing [burrito/bean.txt, burrito/beef.txt, taco.txt]",
+		m.Description())
+
+	cases := []struct {
+		candidate interface{}
+		err       string
+	}{
+		// Exact match. Empty directories don't matter.
+		{
+			fstest.MapFS{
+				"taco.txt":         {Data: []byte("taco")},
+				"burrito/beef.txt": {Data: []byte("beef")},
+				"burrito/bean.txt": {Data: []byte("bean")},
+				"empty":            {Mode: fs.ModeDir | 0755},
+			},
+			"",
+		},
+
+		// Every kind of difference.
+		{
+			fstest.MapFS{
+				"taco.txt":          {Data: []byte("TACO")},
+				"burrito/beef.txt":  {Data: []byte("beef")},
+				"burrito/fish.txt":  {Data: []byte("fish")},
+				"enchilada/red.txt": {Data: []byte("red")},
+			},
+			"which is missing burrito/bean.txt; " +
+				"which has unexpected files burrito/fish.txt, enchilada/red.txt; " +
+				"which has different contents in taco.txt",
+		},
+
+		// Wrong type.
+		{"taco", "which is not an fs.FS"},
+	}
+
+	for i, c := range cases {
+		var actual string
+		if err := m.Matches(c.candidate); err != nil {
+			actual = err.Error()
+		}
+
+		if actual != c.err {
+			t.Errorf("Case %d: expected %q, got %q", i, c.err, actual)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// Golden compares actual against the contents of the golden file
+// testdata/<name>.golden, alongside the calling test's source file, adding a
+// failure record containing a unified diff if they differ. If the
+// --ogletest.update-golden flag is set, the golden file is instead
+// overwritten with actual, creating it if necessary.
+//
+// For example:
+//
+//     Golden("render_index", renderPage(index))
+//
+func Golden(name string, actual []byte) {
+	if currentlyRunningTest == nil {
+		panic("Golden: no test info.")
+	}
+
+	checkGolden("Golden", testDataPath(1, name+".golden"), actual, Diff, 1)
+}
+
+// GoldenString is like Golden, but for text. It uses the golden file
+// testdata/<name>.golden.txt, and failure messages show a diff with line
+// numbers.
+//
+// For example:
+//
+//     GoldenString("usage", cmd.UsageString())
+//
+func GoldenString(name string, actual string) {
+	if currentlyRunningTest == nil {
+		panic("GoldenString: no test info.")
+	}
+
+	checkGolden(
+		"GoldenString",
+		testDataPath(1, name+".golden.txt"),
+		[]byte(actual),
+		numberedDiff,
+		1)
+}
+
+// Compare actual against the golden file at the supplied path, or update it
+// as described for Golden. fn is the name of the user-facing function, for
+// use in failure messages, diff computes the diff to show on mismatch, and
+// depth is the distance on the stack between the caller's frame and the
+// user's frame.
+func checkGolden(
+	fn string,
+	path string,
+	actual []byte,
+	diff func(want, got string) string,
+	depth int) {
+	if *fUpdateGolden {
+		err := os.MkdirAll(filepath.Dir(path), 0755)
+		if err == nil {
+			err = ioutil.WriteFile(path, actual, 0644)
+		}
+
+		if err != nil {
+			recordFailure(depth+1, fmt.Sprintf("%s: %v", fn, err), nil)
+		}
+
+		return
+	}
+
+	expected, err := ioutil.ReadFile(path)
+	if err != nil {
+		recordFailure(
+			depth+1,
+			fmt.Sprintf(
+				"%s: %v\n(Run with --ogletest.update-golden to create it.)",
+				fn,
+				err),
+			nil)
+
+		return
+	}
+
+	if d := diff(string(expected), string(actual)); d != "" {
+		recordFailure(
+			depth+1,
+			fmt.Sprintf("Output differs from golden file %s:\n%s", path, d),
+			nil)
+	}
+}
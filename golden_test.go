@@ -0,0 +1,61 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCheckGolden(t *testing.T) {
+	setUpCurrentTest()
+
+	dir, err := ioutil.TempDir("", "golden_test")
+	if err != nil {
+		t.Fatalf("TempDir: %v", err)
+	}
+
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "testdata", "foo.golden")
+
+	// A missing file is an error.
+	checkGolden("Golden", path, []byte("taco\n"), Diff, 0)
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+	expectEqStr(t, "golden_test.go", currentlyRunningTest.failureRecords[0].FileName)
+
+	// Updating creates it.
+	setUpCurrentTest()
+	*fUpdateGolden = true
+	checkGolden("Golden", path, []byte("taco\n"), Diff, 0)
+	*fUpdateGolden = false
+
+	assertEqInt(t, 0, len(currentlyRunningTest.failureRecords))
+
+	// Now matching output passes, and other output fails with a diff.
+	checkGolden("Golden", path, []byte("taco\n"), Diff, 0)
+	assertEqInt(t, 0, len(currentlyRunningTest.failureRecords))
+
+	checkGolden("Golden", path, []byte("burrito\n"), Diff, 0)
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+
+	msg := currentlyRunningTest.failureRecords[0].Error
+	if !strings.HasSuffix(msg, "@@ -1 +1 @@\n-taco\n+burrito\n") {
+		t.Errorf("Unexpected message: %s", msg)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// The methods of Gomega's types.GomegaMatcher interface, declared here to
+// avoid a dependency on Gomega.
+type gomegaMatcher interface {
+	Match(actual interface{}) (success bool, err error)
+	FailureMessage(actual interface{}) (message string)
+	NegatedFailureMessage(actual interface{}) (message string)
+}
+
+// GomegaMatcher adapts a Gomega matcher, i.e. any value with the methods of
+// Gomega's types.GomegaMatcher interface, for use with ogletest. This allows
+// existing matcher libraries to be used while migrating between the two.
+// Panics if g doesn't have the required methods.
+//
+// An error returned by the Gomega matcher's Match method is treated as the
+// candidate being of the wrong type, as with oglematchers.FatalError.
+//
+// For example:
+//
+//     ExpectThat(body, GomegaMatcher(gomega.ContainSubstring("taco")))
+//
+func GomegaMatcher(g interface{}) oglematchers.Matcher {
+	wrapped, ok := g.(gomegaMatcher)
+	if !ok {
+		panic(fmt.Sprintf("GomegaMatcher: %T is not a Gomega matcher", g))
+	}
+
+	return &gomegaMatcherAdapter{wrapped}
+}
+
+type gomegaMatcherAdapter struct {
+	wrapped gomegaMatcher
+}
+
+func (m *gomegaMatcherAdapter) Description() string {
+	return fmt.Sprintf("satisfies %T", m.wrapped)
+}
+
+func (m *gomegaMatcherAdapter) Matches(c interface{}) error {
+	success, err := m.wrapped.Match(c)
+	if err != nil {
+		return oglematchers.NewFatalError(err.Error())
+	}
+
+	if success {
+		return nil
+	}
+
+	return errors.New(
+		fmt.Sprintf("which fails with:\n%s", m.wrapped.FailureMessage(c)))
+}
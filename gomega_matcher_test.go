@@ -0,0 +1,65 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// A matcher in the style of Gomega that matches the integer 17.
+type fakeGomegaMatcher struct{}
+
+func (m *fakeGomegaMatcher) Match(actual interface{}) (bool, error) {
+	n, ok := actual.(int)
+	if !ok {
+		return false, errors.New("not an int")
+	}
+
+	return n == 17, nil
+}
+
+func (m *fakeGomegaMatcher) FailureMessage(actual interface{}) string {
+	return fmt.Sprintf("Expected\n    %v\nto be 17", actual)
+}
+
+func (m *fakeGomegaMatcher) NegatedFailureMessage(actual interface{}) string {
+	return fmt.Sprintf("Expected\n    %v\nnot to be 17", actual)
+}
+
+func TestGomegaMatcher(t *testing.T) {
+	m := GomegaMatcher(&fakeGomegaMatcher{})
+	expectEqStr(t, "satisfies *ogletest.fakeGomegaMatcher", m.Description())
+
+	if err := m.Matches(17); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	err := m.Matches(19)
+	if err == nil {
+		t.Fatalf("Expected an error.")
+	}
+
+	expectEqStr(t, "which fails with:\nExpected\n    19\nto be 17", err.Error())
+
+	err = m.Matches("taco")
+	if _, ok := err.(*oglematchers.FatalError); !ok {
+		t.Errorf("Expected a fatal error, got %v", err)
+	}
+}
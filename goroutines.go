@@ -0,0 +1,131 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"runtime/pprof"
+	"time"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// How long ExpectNoGoroutineLeak waits for goroutines that are on their way
+// out to finish exiting.
+const goroutineDrainTimeout = 250 * time.Millisecond
+
+// ExpectGoroutineCount checks the current number of live goroutines against
+// m, adding a failure record to the currently running test if it doesn't
+// match. The failure message includes a summary of the live goroutines. Extra
+// parameters are treated as in ExpectThat.
+//
+// For example:
+//
+//     ExpectGoroutineCount(LessThan(10))
+//
+func ExpectGoroutineCount(
+	m oglematchers.Matcher,
+	errorParts ...interface{}) {
+	expectGoroutineCount(m, 1, errorParts)
+}
+
+// The generalized form of ExpectGoroutineCount. depth is the distance on the
+// stack between the caller's frame and the user's frame. Returns passed iff
+// the count matched.
+func expectGoroutineCount(
+	m oglematchers.Matcher,
+	depth int,
+	errorParts []interface{}) (passed bool) {
+	n := runtime.NumGoroutine()
+	matcherErr := m.Matches(n)
+	if matcherErr == nil {
+		passed = true
+		return
+	}
+
+	msg := fmt.Sprintf(
+		"Expected: %s goroutines\nActual:   %d%s\n\n%s",
+		m.Description(),
+		n,
+		relativeClause(m, n, matcherErr),
+		goroutineDump())
+
+	recordFailure(depth+1, msg, errorParts)
+	return
+}
+
+// ExpectNoGoroutineLeak confirms that no more than baseline goroutines are
+// live, adding a failure record to the currently running test if there are
+// more. Goroutines that are still in the process of exiting are given a brief
+// period to finish before the count is checked. Extra parameters are treated
+// as in ExpectThat.
+//
+// For example:
+//
+//     baseline := runtime.NumGoroutine()
+//     server.Start()
+//     server.Stop()
+//     ExpectNoGoroutineLeak(baseline)
+//
+func ExpectNoGoroutineLeak(baseline int, errorParts ...interface{}) {
+	expectNoGoroutineLeak(baseline, 1, errorParts)
+}
+
+// The generalized form of ExpectNoGoroutineLeak. depth is the distance on the
+// stack between the caller's frame and the user's frame. Returns passed iff
+// the count fell to baseline or below.
+func expectNoGoroutineLeak(
+	baseline int,
+	depth int,
+	errorParts []interface{}) (passed bool) {
+	deadline := time.Now().Add(goroutineDrainTimeout)
+
+	var n int
+	for {
+		if n = runtime.NumGoroutine(); n <= baseline {
+			passed = true
+			return
+		}
+
+		if !time.Now().Before(deadline) {
+			break
+		}
+
+		time.Sleep(eventuallyPollInterval)
+	}
+
+	msg := fmt.Sprintf(
+		"Expected: at most %d goroutines\nActual:   %d\n\n%s",
+		baseline,
+		n,
+		goroutineDump())
+
+	recordFailure(depth+1, msg, errorParts)
+	return
+}
+
+// Return a summary of the live goroutines, grouped by stack and including
+// any profiler labels, as produced by the runtime/pprof goroutine profile.
+func goroutineDump() string {
+	var buf bytes.Buffer
+	if err := pprof.Lookup("goroutine").WriteTo(&buf, 1); err != nil {
+		return fmt.Sprintf("(Error dumping goroutines: %v)", err)
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,73 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"runtime"
+	"strings"
+	"testing"
+
+	. "github.com/jacobsa/oglematchers"
+)
+
+func TestExpectGoroutineCount(t *testing.T) {
+	setUpCurrentTest()
+
+	ExpectGoroutineCount(Any())
+	assertEqInt(t, 0, len(currentlyRunningTest.failureRecords))
+
+	ExpectGoroutineCount(
+		&fakeExpectThatMatcher{"taco", errors.New("which is foo")})
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+
+	record := currentlyRunningTest.failureRecords[0]
+	expectEqStr(t, "goroutines_test.go", record.FileName)
+	if !strings.HasPrefix(record.Error, "Expected: taco goroutines\nActual:   ") {
+		t.Errorf("Unexpected error: %q", record.Error)
+	}
+
+	if !strings.Contains(record.Error, "goroutine profile:") {
+		t.Errorf("Missing goroutine dump: %q", record.Error)
+	}
+}
+
+func TestExpectNoGoroutineLeak(t *testing.T) {
+	setUpCurrentTest()
+
+	// A goroutine that exits shortly after we check is not a leak.
+	baseline := runtime.NumGoroutine()
+	done := make(chan struct{})
+	go func() { <-done }()
+	close(done)
+
+	ExpectNoGoroutineLeak(baseline)
+	assertEqInt(t, 0, len(currentlyRunningTest.failureRecords))
+
+	// One that stays blocked is.
+	block := make(chan struct{})
+	defer close(block)
+	go func() { <-block }()
+
+	ExpectNoGoroutineLeak(baseline)
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+
+	record := currentlyRunningTest.failureRecords[0]
+	expectEqStr(t, "goroutines_test.go", record.FileName)
+	if !strings.Contains(record.Error, "TestExpectNoGoroutineLeak") {
+		t.Errorf("Missing leaked goroutine in dump: %q", record.Error)
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// HaveOccurred returns a matcher that matches non-nil errors. It is the
+// opposite of Succeed, with a failure message that reads more naturally than
+// that of Not(Succeed()).
+//
+// For example:
+//
+//     _, err := strconv.Atoi("taco")
+//     ExpectThat(err, HaveOccurred())
+//
+func HaveOccurred() oglematchers.Matcher {
+	return &haveOccurredMatcher{}
+}
+
+type haveOccurredMatcher struct{}
+
+func (m *haveOccurredMatcher) Description() string {
+	return "an error to have occurred"
+}
+
+func (m *haveOccurredMatcher) Matches(c interface{}) error {
+	if err, ok := c.(error); ok && err != nil {
+		return nil
+	}
+
+	if c == nil {
+		return errors.New("")
+	}
+
+	return errors.New("which is not an error")
+}
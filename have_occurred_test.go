@@ -0,0 +1,44 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestHaveOccurred(t *testing.T) {
+	m := HaveOccurred()
+
+	if err := m.Matches(errors.New("taco")); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	setUpCurrentTest()
+	ExpectThat(nil, m)
+	ExpectThat(17, m)
+
+	assertEqInt(t, 2, len(currentlyRunningTest.failureRecords))
+	expectEqStr(
+		t,
+		"Expected: an error to have occurred\nActual:   <nil>",
+		currentlyRunningTest.failureRecords[0].Error)
+
+	expectEqStr(
+		t,
+		"Expected: an error to have occurred\nActual:   17, which is not an error",
+		currentlyRunningTest.failureRecords[1].Error)
+}
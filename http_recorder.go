@@ -0,0 +1,161 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+)
+
+// An HTTPRecorder serves HTTP requests with a handler supplied by the test,
+// recording each request and the response it received. Create one with
+// NewHTTPRecorder. An HTTPRecorder is safe for concurrent use.
+//
+// For example:
+//
+//     recorder, client := NewHTTPRecorder(http.NotFoundHandler())
+//     c := NewAPIClient(client)
+//
+//     c.Fetch("taco")
+//     AssertEq(1, len(recorder.Requests()))
+//     ExpectEq("/v1/items/taco", recorder.Requests()[0].URL.Path)
+//
+type HTTPRecorder struct {
+	mu sync.Mutex
+
+	// The requests served so far and their responses, in order.
+	//
+	// GUARDED_BY(mu)
+	requests  []*http.Request
+	responses []*http.Response
+}
+
+// NewHTTPRecorder starts a server that records each request and passes it to
+// the supplied handler, returning the recorder along with a client that sends
+// all of its requests to that server, whatever host they are addressed to.
+// The Host header of each request is preserved. The server is closed after
+// the currently running test's TearDown method has run.
+func NewHTTPRecorder(handler http.Handler) (*HTTPRecorder, *http.Client) {
+	if currentlyRunningTest == nil {
+		panic("NewHTTPRecorder: no test info.")
+	}
+
+	r := &HTTPRecorder{}
+	server := HTTPTestServer(http.HandlerFunc(
+		func(w http.ResponseWriter, req *http.Request) {
+			r.serve(handler, w, req)
+		}))
+
+	target, err := url.Parse(server.URL)
+	if err != nil {
+		panic("NewHTTPRecorder: url.Parse: " + err.Error())
+	}
+
+	client := &http.Client{
+		Transport: &redirectingTransport{
+			target:  target,
+			wrapped: server.Client().Transport,
+		},
+	}
+
+	return r, client
+}
+
+// Requests returns the requests served so far, in order. Their bodies are
+// in-memory copies of what was sent.
+func (r *HTTPRecorder) Requests() []*http.Request {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	requests := make([]*http.Request, len(r.requests))
+	copy(requests, r.requests)
+	return requests
+}
+
+// Responses returns the responses to the requests returned by Requests, in
+// the same order.
+func (r *HTTPRecorder) Responses() []*http.Response {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	responses := make([]*http.Response, len(r.responses))
+	copy(responses, r.responses)
+	return responses
+}
+
+// Serve a single request with the supplied handler, recording the request
+// and the response.
+func (r *HTTPRecorder) serve(
+	handler http.Handler,
+	w http.ResponseWriter,
+	req *http.Request) {
+	// Read the body so that both the handler and the test can see it.
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	// Serve the request into a recorder, then copy the response out.
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	for k, v := range rec.Header() {
+		w.Header()[k] = v
+	}
+
+	w.WriteHeader(rec.Code)
+	w.Write(rec.Body.Bytes())
+
+	// Record a copy of the request with a fresh body.
+	recorded := req.Clone(req.Context())
+	recorded.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	resp := rec.Result()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.requests = append(r.requests, recorded)
+	r.responses = append(r.responses, resp)
+}
+
+// An http.RoundTripper that sends every request to the same server, leaving
+// the Host header as it was.
+type redirectingTransport struct {
+	target  *url.URL
+	wrapped http.RoundTripper
+}
+
+func (t *redirectingTransport) RoundTrip(
+	req *http.Request) (*http.Response, error) {
+	// RoundTrippers must not modify the request they are given.
+	out := req.Clone(req.Context())
+	if out.Host == "" {
+		out.Host = req.URL.Host
+	}
+
+	out.URL.Scheme = t.target.Scheme
+	out.URL.Host = t.target.Host
+
+	return t.wrapped.RoundTrip(out)
+}
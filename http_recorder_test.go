@@ -0,0 +1,68 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestHTTPRecorder(t *testing.T) {
+	setUpCurrentTest()
+	defer currentlyRunningTest.runCleanups()
+
+	recorder, client := NewHTTPRecorder(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			w.Header().Set("X-Taco", "burrito")
+			w.WriteHeader(http.StatusTeapot)
+			w.Write([]byte("got " + string(body)))
+		}))
+
+	resp, err := client.Post(
+		"http://api.example.com/v1/items",
+		"text/plain",
+		strings.NewReader("enchilada"))
+
+	if err != nil {
+		t.Fatalf("Post: %v", err)
+	}
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	expectEqInt(t, http.StatusTeapot, resp.StatusCode)
+	expectEqStr(t, "burrito", resp.Header.Get("X-Taco"))
+	expectEqStr(t, "got enchilada", string(body))
+
+	// The exchange should have been recorded.
+	requests := recorder.Requests()
+	assertEqInt(t, 1, len(requests))
+	expectEqStr(t, "POST", requests[0].Method)
+	expectEqStr(t, "api.example.com", requests[0].Host)
+	expectEqStr(t, "/v1/items", requests[0].URL.Path)
+
+	body, _ = ioutil.ReadAll(requests[0].Body)
+	expectEqStr(t, "enchilada", string(body))
+
+	responses := recorder.Responses()
+	assertEqInt(t, 1, len(responses))
+	expectEqInt(t, http.StatusTeapot, responses[0].StatusCode)
+
+	body, _ = ioutil.ReadAll(responses[0].Body)
+	expectEqStr(t, "got enchilada", string(body))
+}
@@ -0,0 +1,203 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// HTTPStatusCode returns a matcher for *http.Response values that matches
+// responses with the supplied status code.
+func HTTPStatusCode(code int) oglematchers.Matcher {
+	return &httpStatusCodeMatcher{code}
+}
+
+type httpStatusCodeMatcher struct {
+	code int
+}
+
+func (m *httpStatusCodeMatcher) Description() string {
+	return fmt.Sprintf("status code %d", m.code)
+}
+
+func (m *httpStatusCodeMatcher) Matches(c interface{}) error {
+	resp, err := toHTTPResponse(c)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != m.code {
+		return errors.New(fmt.Sprintf("which has status code %d", resp.StatusCode))
+	}
+
+	return nil
+}
+
+// HTTPHeader returns a matcher for *http.Response values that matches
+// responses whose first value for the supplied header is value.
+func HTTPHeader(key string, value string) oglematchers.Matcher {
+	return &httpHeaderMatcher{http.CanonicalHeaderKey(key), value}
+}
+
+type httpHeaderMatcher struct {
+	key   string
+	value string
+}
+
+func (m *httpHeaderMatcher) Description() string {
+	return fmt.Sprintf("header %s: %q", m.key, m.value)
+}
+
+func (m *httpHeaderMatcher) Matches(c interface{}) error {
+	resp, err := toHTTPResponse(c)
+	if err != nil {
+		return err
+	}
+
+	values, ok := resp.Header[m.key]
+	switch {
+	case !ok:
+		return errors.New(fmt.Sprintf("which has no %s header", m.key))
+
+	case values[0] != m.value:
+		return errors.New(fmt.Sprintf("which has %s: %q", m.key, values[0]))
+	}
+
+	return nil
+}
+
+// HTTPBodyContains returns a matcher for *http.Response values that matches
+// responses whose body contains s. The body is read in full and then replaced
+// with an in-memory copy, so it may be inspected again afterward.
+func HTTPBodyContains(s string) oglematchers.Matcher {
+	return &httpBodyContainsMatcher{s}
+}
+
+type httpBodyContainsMatcher struct {
+	s string
+}
+
+func (m *httpBodyContainsMatcher) Description() string {
+	return fmt.Sprintf("body containing %q", m.s)
+}
+
+func (m *httpBodyContainsMatcher) Matches(c interface{}) error {
+	resp, err := toHTTPResponse(c)
+	if err != nil {
+		return err
+	}
+
+	var body []byte
+	if resp.Body != nil {
+		if body, err = ioutil.ReadAll(resp.Body); err != nil {
+			return oglematchers.NewFatalError(
+				fmt.Sprintf("which has an unreadable body: %v", err))
+		}
+
+		resp.Body.Close()
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	if !strings.Contains(string(body), m.s) {
+		return errors.New(fmt.Sprintf("which has body %q", body))
+	}
+
+	return nil
+}
+
+func toHTTPResponse(c interface{}) (*http.Response, error) {
+	resp, ok := c.(*http.Response)
+	if !ok || resp == nil {
+		return nil, oglematchers.NewFatalError("which is not a *http.Response")
+	}
+
+	return resp, nil
+}
+
+// ExpectHTTPResponse applies each of the supplied matchers to resp, adding a
+// single failure record to the currently running test that lists every
+// matcher that did not match, if any. The matchers will usually be some of
+// HTTPStatusCode, HTTPHeader, and HTTPBodyContains, but any matcher that
+// accepts *http.Response values may be used.
+//
+// For example:
+//
+//     ExpectHTTPResponse(
+//       resp,
+//       HTTPStatusCode(200),
+//       HTTPHeader("Content-Type", "application/json"),
+//       HTTPBodyContains(`"name":"taco"`))
+//
+func ExpectHTTPResponse(
+	resp *http.Response,
+	matchers ...oglematchers.Matcher) {
+	expectHTTPResponse(resp, matchers, 1)
+}
+
+// AssertHTTPResponse is identical to ExpectHTTPResponse, except that in the
+// event of failure it halts the currently running test immediately.
+func AssertHTTPResponse(
+	resp *http.Response,
+	matchers ...oglematchers.Matcher) {
+	if !expectHTTPResponse(resp, matchers, 1) {
+		AbortTest()
+	}
+}
+
+// The generalized form of ExpectHTTPResponse. depth is the distance on the
+// stack between the caller's frame and the user's frame. Returns passed iff
+// all of the matchers matched.
+func expectHTTPResponse(
+	resp *http.Response,
+	matchers []oglematchers.Matcher,
+	depth int) (passed bool) {
+	var descriptions []string
+	var mismatches []string
+	for _, m := range matchers {
+		descriptions = append(descriptions, m.Description())
+		if err := m.Matches(resp); err != nil {
+			mismatches = append(
+				mismatches,
+				fmt.Sprintf("  %s%s", m.Description(), relativeClause(m, resp, err)))
+		}
+	}
+
+	if len(mismatches) == 0 {
+		passed = true
+		return
+	}
+
+	actual := "nil"
+	if resp != nil {
+		actual = "HTTP response " + resp.Status
+	}
+
+	msg := fmt.Sprintf(
+		"Expected: HTTP response with %s\nActual:   %s\nMismatches:\n%s",
+		strings.Join(descriptions, ", and "),
+		actual,
+		strings.Join(mismatches, "\n"))
+
+	recordFailure(depth+1, msg, nil)
+	return
+}
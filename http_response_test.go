@@ -0,0 +1,97 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestResponse() *http.Response {
+	rec := httptest.NewRecorder()
+	rec.Header().Set("Content-Type", "text/plain")
+	rec.WriteHeader(http.StatusNotFound)
+	rec.WriteString("no tacos here")
+
+	return rec.Result()
+}
+
+func TestHTTPResponseMatchers(t *testing.T) {
+	resp := newTestResponse()
+
+	cases := []struct {
+		err      error
+		expected string
+	}{
+		{HTTPStatusCode(404).Matches(resp), ""},
+		{HTTPStatusCode(200).Matches(resp), "which has status code 404"},
+		{HTTPHeader("content-type", "text/plain").Matches(resp), ""},
+		{
+			HTTPHeader("Content-Type", "text/html").Matches(resp),
+			`which has Content-Type: "text/plain"`,
+		},
+		{HTTPHeader("X-Taco", "").Matches(resp), "which has no X-Taco header"},
+		{HTTPBodyContains("tacos").Matches(resp), ""},
+		{HTTPBodyContains("burrito").Matches(resp), `which has body "no tacos here"`},
+		{HTTPStatusCode(200).Matches(17), "which is not a *http.Response"},
+	}
+
+	for i, c := range cases {
+		var actual string
+		if c.err != nil {
+			actual = c.err.Error()
+		}
+
+		if actual != c.expected {
+			t.Errorf("Case %d: expected %q, got %q", i, c.expected, actual)
+		}
+	}
+
+	// The body should still be readable after matching.
+	body, _ := ioutil.ReadAll(resp.Body)
+	expectEqStr(t, "no tacos here", string(body))
+}
+
+func TestExpectHTTPResponse(t *testing.T) {
+	setUpCurrentTest()
+	resp := newTestResponse()
+
+	ExpectHTTPResponse(resp, HTTPStatusCode(404), HTTPBodyContains("tacos"))
+	assertEqInt(t, 0, len(currentlyRunningTest.failureRecords))
+
+	ExpectHTTPResponse(
+		resp,
+		HTTPStatusCode(200),
+		HTTPHeader("Content-Type", "text/plain"),
+		HTTPBodyContains("burrito"))
+
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+	record := currentlyRunningTest.failureRecords[0]
+
+	expectEqStr(t, "http_response_test.go", record.FileName)
+	expectEqStr(
+		t,
+		"Expected: HTTP response with status code 200, "+
+			"and header Content-Type: \"text/plain\", "+
+			"and body containing \"burrito\"\n"+
+			"Actual:   HTTP response 404 Not Found\n"+
+			"Mismatches:\n"+
+			"  status code 200, which has status code 404\n"+
+			"  body containing \"burrito\", which has body \"no tacos here\"",
+		record.Error)
+}
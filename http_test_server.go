@@ -0,0 +1,43 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"net/http"
+	"net/http/httptest"
+)
+
+// HTTPTestServer starts an httptest.Server serving the supplied handler, and
+// arranges for it to be closed after the currently running test's TearDown
+// method has run.
+//
+// For example:
+//
+//     server := HTTPTestServer(http.FileServer(http.Dir("testdata")))
+//
+//     resp, err := http.Get(server.URL + "/taco.txt")
+//
+func HTTPTestServer(handler http.Handler) *httptest.Server {
+	info := currentlyRunningTest
+	if info == nil {
+		panic("HTTPTestServer: no test info.")
+	}
+
+	server := httptest.NewServer(handler)
+	info.addCleanup(server.Close)
+
+	return server
+}
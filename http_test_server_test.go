@@ -0,0 +1,58 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestHTTPTestServer(t *testing.T) {
+	setUpCurrentTest()
+
+	server := HTTPTestServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("taco"))
+		}))
+
+	resp, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	expectEqStr(t, "taco", string(body))
+
+	// The server should be gone after the test's cleanups run.
+	currentlyRunningTest.runCleanups()
+	if _, err := http.Get(server.URL); err == nil {
+		t.Errorf("Expected an error after cleanup.")
+	}
+}
+
+func TestHTTPTestServerOutsideTest(t *testing.T) {
+	currentlyRunningTest = nil
+
+	defer func() {
+		if r := recover(); r != "HTTPTestServer: no test info." {
+			t.Errorf("Unexpected panic: %v", r)
+		}
+	}()
+
+	HTTPTestServer(http.NotFoundHandler())
+}
@@ -142,6 +142,9 @@ func cleanOutput(o []byte, testPkg string) []byte {
 	timingRe4 := regexp.MustCompile(`SlowTest \([0-9.]+ms\)`)
 	o = timingRe4.ReplaceAll(o, []byte("SlowTest (1234ms)"))
 
+	timingRe5 := regexp.MustCompile(`(Ran \d+ tests in )[0-9.]+s`)
+	o = timingRe5.ReplaceAll(o, []byte("${1}1.2s"))
+
 	// Replace arch-dependent runtime.call32 etc. with runtime.callXX
 	callRe := regexp.MustCompile(`runtime.call\d+`)
 	o = callRe.ReplaceAll(o, []byte("runtime.callXX"))
@@ -185,10 +188,26 @@ func runTestCase(name string) ([]byte, int, error) {
 
 	// Invoke 'go test'. Use the package directory as working dir instead of
 	// giving the package name as an argument so that 'go test' prints passing
-	// test output. Special case: pass a test filter to the filtered case.
+	// test output. Special cases: pass a test filter to the filtered case, ask
+	// for reruns in the rerun case, ask for the corresponding output modes in
+	// the dots and summary_only cases, and limit failures in the max_failures
+	// case.
 	cmd := exec.Command("go", "test")
-	if name == "filtered" {
+	switch name {
+	case "filtered":
 		cmd.Args = append(cmd.Args, "--ogletest.run=Test(Bar|Baz)")
+
+	case "rerun":
+		cmd.Args = append(cmd.Args, "--ogletest.rerun-fails=2")
+
+	case "dots":
+		cmd.Args = append(cmd.Args, "--ogletest.dots")
+
+	case "summary_only":
+		cmd.Args = append(cmd.Args, "--ogletest.summary-only")
+
+	case "max_failures":
+		cmd.Args = append(cmd.Args, "--ogletest.max-failures=2")
 	}
 
 	cmd.Dir = testDir
@@ -254,7 +273,11 @@ func TestGoldenFiles(t *testing.T) {
 
 		// Check the status code. We assume all test cases fail except for the
 		// passing one.
-		shouldPass := (caseName == "passing" || caseName == "no_cases")
+		shouldPass := (caseName == "passing" ||
+			caseName == "no_cases" ||
+			caseName == "focused" ||
+			caseName == "pending" ||
+			caseName == "test_main")
 		didPass := exitCode == 0
 		if shouldPass != didPass {
 			t.Errorf("Bad exit code for test case %s: %d", caseName, exitCode)
@@ -0,0 +1,71 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// IsBefore returns a matcher for time.Time values that matches times strictly
+// before t.
+func IsBefore(t time.Time) oglematchers.Matcher {
+	return &timeOrderMatcher{t: t, before: true}
+}
+
+// IsAfter returns a matcher for time.Time values that matches times strictly
+// after t.
+func IsAfter(t time.Time) oglematchers.Matcher {
+	return &timeOrderMatcher{t: t, before: false}
+}
+
+type timeOrderMatcher struct {
+	t      time.Time
+	before bool
+}
+
+func (m *timeOrderMatcher) Description() string {
+	if m.before {
+		return fmt.Sprintf("time before %v", m.t)
+	}
+
+	return fmt.Sprintf("time after %v", m.t)
+}
+
+func (m *timeOrderMatcher) Matches(c interface{}) error {
+	t, ok := c.(time.Time)
+	if !ok {
+		return oglematchers.NewFatalError("which is not a time.Time")
+	}
+
+	d := t.Sub(m.t)
+	switch {
+	case m.before && d < 0, !m.before && d > 0:
+		return nil
+
+	case d == 0:
+		return errors.New("which is the same time")
+
+	case d > 0:
+		return errors.New(fmt.Sprintf("which is %v later", d))
+
+	default:
+		return errors.New(fmt.Sprintf("which is %v earlier", -d))
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+func TestIsBeforeAndIsAfter(t *testing.T) {
+	noon := time.Date(2015, 1, 1, 12, 0, 0, 0, time.UTC)
+	morning := noon.Add(-3 * time.Hour)
+
+	expectEqStr(t, "time before 2015-01-01 12:00:00 +0000 UTC", IsBefore(noon).Description())
+	expectEqStr(t, "time after 2015-01-01 12:00:00 +0000 UTC", IsAfter(noon).Description())
+
+	if err := IsBefore(noon).Matches(morning); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if err := IsAfter(morning).Matches(noon); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	err := IsBefore(morning).Matches(noon)
+	if err == nil {
+		t.Fatalf("Expected an error.")
+	}
+
+	expectEqStr(t, "which is 3h0m0s later", err.Error())
+
+	err = IsAfter(noon).Matches(morning)
+	if err == nil {
+		t.Fatalf("Expected an error.")
+	}
+
+	expectEqStr(t, "which is 3h0m0s earlier", err.Error())
+
+	err = IsAfter(noon).Matches(noon)
+	if err == nil {
+		t.Fatalf("Expected an error.")
+	}
+
+	expectEqStr(t, "which is the same time", err.Error())
+
+	err = IsBefore(noon).Matches("noon")
+	if _, ok := err.(*oglematchers.FatalError); !ok {
+		t.Errorf("Expected a fatal error, got %v", err)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// IsPast returns a matcher for time.Time values that matches times before the
+// moment at which the match is performed.
+func IsPast() oglematchers.Matcher {
+	return &relativeToNowMatcher{past: true}
+}
+
+// IsFuture returns a matcher for time.Time values that matches times after
+// the moment at which the match is performed.
+func IsFuture() oglematchers.Matcher {
+	return &relativeToNowMatcher{past: false}
+}
+
+type relativeToNowMatcher struct {
+	past bool
+}
+
+func (m *relativeToNowMatcher) Description() string {
+	if m.past {
+		return "time in the past"
+	}
+
+	return "time in the future"
+}
+
+func (m *relativeToNowMatcher) Matches(c interface{}) error {
+	t, ok := c.(time.Time)
+	if !ok {
+		return oglematchers.NewFatalError("which is not a time.Time")
+	}
+
+	d := t.Sub(time.Now())
+	switch {
+	case m.past && d < 0, !m.past && d > 0:
+		return nil
+
+	case d >= 0:
+		return errors.New(fmt.Sprintf("which is %v from now", d))
+
+	default:
+		return errors.New(fmt.Sprintf("which is %v ago", -d))
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIsPastAndIsFuture(t *testing.T) {
+	hourAgo := time.Now().Add(-time.Hour)
+	hourHence := time.Now().Add(time.Hour)
+
+	expectEqStr(t, "time in the past", IsPast().Description())
+	expectEqStr(t, "time in the future", IsFuture().Description())
+
+	if err := IsPast().Matches(hourAgo); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if err := IsFuture().Matches(hourHence); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	err := IsPast().Matches(hourHence)
+	if err == nil || !strings.HasSuffix(err.Error(), " from now") {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	err = IsFuture().Matches(hourAgo)
+	if err == nil || !strings.HasSuffix(err.Error(), " ago") {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// IsWithin returns a matcher for time.Time values that matches times no more
+// than d before or after the supplied time. This is useful for checking
+// timestamps recorded by code that calls time.Now itself.
+//
+// For example:
+//
+//     ExpectThat(record.CreatedAt, IsWithin(time.Second, time.Now()))
+//
+func IsWithin(d time.Duration, of time.Time) oglematchers.Matcher {
+	return &isWithinMatcher{d, of}
+}
+
+type isWithinMatcher struct {
+	tolerance time.Duration
+	of        time.Time
+}
+
+func (m *isWithinMatcher) Description() string {
+	return fmt.Sprintf("time within %v of %v", m.tolerance, m.of)
+}
+
+func (m *isWithinMatcher) Matches(c interface{}) error {
+	t, ok := c.(time.Time)
+	if !ok {
+		return oglematchers.NewFatalError("which is not a time.Time")
+	}
+
+	// Compare against the bounds directly, since t.Sub saturates for times
+	// far apart (such as the zero time and now).
+	if !t.Before(m.of.Add(-m.tolerance)) && !t.After(m.of.Add(m.tolerance)) {
+		return nil
+	}
+
+	diff := t.Sub(m.of)
+	if diff < 0 {
+		diff = -diff
+	}
+
+	// Negating the minimum Duration leaves it negative.
+	if diff < 0 {
+		return errors.New(fmt.Sprintf(
+			"which differs by more than %v",
+			time.Duration(math.MaxInt64)))
+	}
+
+	return errors.New(fmt.Sprintf("which differs by %v", diff))
+}
@@ -0,0 +1,66 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+func TestIsWithin(t *testing.T) {
+	noon := time.Date(2015, 1, 1, 12, 0, 0, 0, time.UTC)
+	m := IsWithin(time.Second, noon)
+
+	expectEqStr(
+		t,
+		"time within 1s of 2015-01-01 12:00:00 +0000 UTC",
+		m.Description())
+
+	for _, c := range []time.Time{noon, noon.Add(time.Second), noon.Add(-time.Second)} {
+		if err := m.Matches(c); err != nil {
+			t.Errorf("Unexpected error for %v: %v", c, err)
+		}
+	}
+
+	err := m.Matches(noon.Add(-1500 * time.Millisecond))
+	if err == nil {
+		t.Fatalf("Expected an error.")
+	}
+
+	expectEqStr(t, "which differs by 1.5s", err.Error())
+
+	err = m.Matches(time.Second)
+	if _, ok := err.(*oglematchers.FatalError); !ok {
+		t.Errorf("Expected a fatal error, got %v", err)
+	}
+}
+
+func TestIsWithinZeroTime(t *testing.T) {
+	// The difference between these saturates time.Duration.
+	m := IsWithin(time.Second, time.Now())
+
+	err := m.Matches(time.Time{})
+	if err == nil {
+		t.Fatalf("Expected an error.")
+	}
+
+	expectEqStr(
+		t,
+		"which differs by more than 2562047h47m16.854775807s",
+		err.Error())
+}
@@ -0,0 +1,238 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+	"sync"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// LogCapture collects the log/slog records emitted during a test. Create one
+// with CaptureLog.
+type LogCapture struct {
+	mu sync.Mutex
+
+	// The records captured so far, in order.
+	//
+	// GUARDED_BY(mu)
+	records []slog.Record
+}
+
+// The LogCapture installed by CaptureLog for the currently running test, or
+// nil if none.
+var currentLogCapture *LogCapture
+
+// CaptureLog replaces the default slog logger with one that captures every
+// record, at every level, for the remainder of the currently running test.
+// The original logger is restored after the test's TearDown method has run,
+// even if the test panics. Output from the standard log package is also
+// captured, since slog.SetDefault redirects it.
+//
+// For example:
+//
+//     logs := CaptureLog()
+//     server.Start()
+//     logs.ExpectLogMessage(HasSubstr("listening"))
+//
+func CaptureLog() *LogCapture {
+	info := currentlyRunningTest
+	if info == nil {
+		panic("CaptureLog: no test info.")
+	}
+
+	// slog.SetDefault redirects the log package to the new handler, and
+	// restoring the original slog logger doesn't undo that, so save its
+	// settings too.
+	originalLogger := slog.Default()
+	originalWriter := log.Writer()
+	originalFlags := log.Flags()
+
+	c := &LogCapture{}
+	slog.SetDefault(slog.New(&captureHandler{c: c}))
+	currentLogCapture = c
+
+	info.addCleanup(func() {
+		currentLogCapture = nil
+		slog.SetDefault(originalLogger)
+		log.SetOutput(originalWriter)
+		log.SetFlags(originalFlags)
+	})
+
+	return c
+}
+
+// Records returns the records captured so far, in order.
+func (c *LogCapture) Records() []slog.Record {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	records := make([]slog.Record, len(c.records))
+	copy(records, c.records)
+	return records
+}
+
+// ExpectLogMessage adds a failure record to the currently running test unless
+// the message of some captured record matches m. Extra parameters are treated
+// as in ExpectThat.
+func (c *LogCapture) ExpectLogMessage(
+	m oglematchers.Matcher,
+	errorParts ...interface{}) {
+	c.expectLogMessage(m, 1, errorParts)
+}
+
+// The generalized form of ExpectLogMessage. depth is the distance on the
+// stack between the caller's frame and the user's frame. Returns passed iff
+// some message matched.
+func (c *LogCapture) expectLogMessage(
+	m oglematchers.Matcher,
+	depth int,
+	errorParts []interface{}) (passed bool) {
+	var messages []string
+	for _, r := range c.Records() {
+		if m.Matches(r.Message) == nil {
+			passed = true
+			return
+		}
+
+		messages = append(messages, r.Message)
+	}
+
+	msg := fmt.Sprintf(
+		"Expected: log message %s\nActual:   %q",
+		m.Description(),
+		messages)
+
+	recordFailure(depth+1, msg, errorParts)
+	return
+}
+
+// ExpectLogContains adds a failure record to the currently running test
+// unless the message of some record captured by CaptureLog contains the
+// supplied string. CaptureLog must have been called earlier in the test.
+// Extra parameters are treated as in ExpectThat.
+//
+// For example:
+//
+//     CaptureLog()
+//     server.Start()
+//     ExpectLogContains("listening")
+//
+func ExpectLogContains(message string, errorParts ...interface{}) {
+	expectLogContains(message, 1, errorParts)
+}
+
+// AssertLogContains is identical to ExpectLogContains, except that in the
+// event of failure it halts the currently running test immediately.
+func AssertLogContains(message string, errorParts ...interface{}) {
+	if !expectLogContains(message, 1, errorParts) {
+		AbortTest()
+	}
+}
+
+// The generalized form of ExpectLogContains. depth is the distance on the
+// stack between the caller's frame and the user's frame. Returns passed iff
+// some message contained the string.
+func expectLogContains(
+	message string,
+	depth int,
+	errorParts []interface{}) (passed bool) {
+	c := currentLogCapture
+	if c == nil {
+		recordFailure(
+			depth+1,
+			"No log output has been captured; call CaptureLog() first.",
+			errorParts)
+
+		return
+	}
+
+	passed = c.expectLogMessage(
+		oglematchers.HasSubstr(message),
+		depth+1,
+		errorParts)
+
+	return
+}
+
+// An slog.Handler that appends every record to a LogCapture.
+type captureHandler struct {
+	c *LogCapture
+
+	// Attributes added with WithAttrs, already nested within their groups.
+	attrs []slog.Attr
+
+	// Groups opened with WithGroup, outermost first.
+	groups []string
+}
+
+func (h *captureHandler) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+func (h *captureHandler) Handle(_ context.Context, r slog.Record) error {
+	var own []slog.Attr
+	r.Attrs(func(a slog.Attr) bool {
+		own = append(own, a)
+		return true
+	})
+
+	out := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+	out.AddAttrs(h.attrs...)
+	out.AddAttrs(nestInGroups(h.groups, own)...)
+
+	h.c.mu.Lock()
+	defer h.c.mu.Unlock()
+	h.c.records = append(h.c.records, out)
+
+	return nil
+}
+
+func (h *captureHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	h2 := *h
+	h2.attrs = append(
+		append([]slog.Attr(nil), h.attrs...),
+		nestInGroups(h.groups, attrs)...)
+
+	return &h2
+}
+
+func (h *captureHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	h2 := *h
+	h2.groups = append(append([]string(nil), h.groups...), name)
+	return &h2
+}
+
+// Wrap the supplied attributes in the supplied groups, outermost first.
+func nestInGroups(groups []string, attrs []slog.Attr) []slog.Attr {
+	if len(attrs) == 0 {
+		return nil
+	}
+
+	for i := len(groups) - 1; i >= 0; i-- {
+		attrs = []slog.Attr{{Key: groups[i], Value: slog.GroupValue(attrs...)}}
+	}
+
+	return attrs
+}
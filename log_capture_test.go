@@ -0,0 +1,107 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"log"
+	"log/slog"
+	"testing"
+
+	. "github.com/jacobsa/oglematchers"
+)
+
+func TestCaptureLog(t *testing.T) {
+	setUpCurrentTest()
+	originalLogger := slog.Default()
+	originalWriter := log.Writer()
+
+	logs := CaptureLog()
+	slog.Debug("taco", "n", 17)
+	slog.With("a", 1).WithGroup("g").Info("burrito", "b", 2)
+	log.Print("enchilada")
+
+	records := logs.Records()
+	assertEqInt(t, 3, len(records))
+	expectEqStr(t, "taco", records[0].Message)
+	expectEqStr(t, "burrito", records[1].Message)
+	expectEqStr(t, "enchilada", records[2].Message)
+
+	var attrs []string
+	records[1].Attrs(func(a slog.Attr) bool {
+		attrs = append(attrs, a.String())
+		return true
+	})
+
+	assertEqInt(t, 2, len(attrs))
+	expectEqStr(t, "a=1", attrs[0])
+	expectEqStr(t, "g=[b=2]", attrs[1])
+
+	// Matching.
+	logs.ExpectLogMessage(Equals("burrito"))
+	assertEqInt(t, 0, len(currentlyRunningTest.failureRecords))
+
+	logs.ExpectLogMessage(&fakeExpectThatMatcher{"taco", errors.New("")})
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+
+	record := currentlyRunningTest.failureRecords[0]
+	expectEqStr(t, "log_capture_test.go", record.FileName)
+	expectEqStr(
+		t,
+		"Expected: log message taco\n"+
+			"Actual:   [\"taco\" \"burrito\" \"enchilada\"]",
+		record.Error)
+
+	// The original loggers should be restored by the test's cleanups.
+	currentlyRunningTest.runCleanups()
+	if slog.Default() != originalLogger {
+		t.Errorf("slog default not restored.")
+	}
+
+	if log.Writer() != originalWriter {
+		t.Errorf("log writer not restored.")
+	}
+}
+
+func TestExpectLogContains(t *testing.T) {
+	setUpCurrentTest()
+
+	// Without a capture.
+	ExpectLogContains("taco")
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+
+	record := currentlyRunningTest.failureRecords[0]
+	expectEqStr(t, "log_capture_test.go", record.FileName)
+	expectEqStr(
+		t,
+		"No log output has been captured; call CaptureLog() first.",
+		record.Error)
+
+	// With one.
+	setUpCurrentTest()
+	CaptureLog()
+	defer currentlyRunningTest.runCleanups()
+
+	slog.Info("burrito bowl")
+	ExpectLogContains("burrito")
+	assertEqInt(t, 0, len(currentlyRunningTest.failureRecords))
+
+	ExpectLogContains("taco")
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+
+	record = currentlyRunningTest.failureRecords[0]
+	expectEqStr(t, "log_capture_test.go", record.FileName)
+}
@@ -0,0 +1,83 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"flag"
+	"os"
+	"testing"
+)
+
+// Functions registered with BeforeSuite and AfterSuite.
+var beforeSuiteFuncs []func()
+var afterSuiteFuncs []func()
+
+// BeforeSuite registers a function to be run by Main before any tests, for
+// example to start a server shared by all of the test suites in the package.
+// Functions are run in the order they were registered.
+//
+// Unlike functions registered with RegisterGlobalSetUp, which RunTests runs,
+// these run before any test function in the package, including ones that
+// don't use ogletest.
+func BeforeSuite(f func()) {
+	beforeSuiteFuncs = append(beforeSuiteFuncs, f)
+}
+
+// AfterSuite registers a function to be run by Main after all tests have
+// finished. Functions are run in the reverse of the order they were
+// registered.
+//
+// Functions registered with RegisterGlobalTearDown run earlier, at the end of
+// RunTests.
+func AfterSuite(f func()) {
+	afterSuiteFuncs = append(afterSuiteFuncs, f)
+}
+
+// Main runs the tests in the package, surrounded by the functions registered
+// with BeforeSuite and AfterSuite, and then exits. It is intended to be called
+// from a TestMain function. Global set-up and tear-down functions registered
+// with RegisterGlobalSetUp and RegisterGlobalTearDown are run inside these, by
+// RunTests.
+//
+// For example:
+//
+//     func init() {
+//       ogletest.BeforeSuite(startDatabase)
+//       ogletest.AfterSuite(stopDatabase)
+//     }
+//
+//     func TestMain(m *testing.M) { ogletest.Main(m) }
+//
+//     func TestOgletest(t *testing.T) { ogletest.RunTests(t) }
+//
+func Main(m *testing.M) {
+	// Make flags available to the set-up functions.
+	if !flag.Parsed() {
+		flag.Parse()
+	}
+
+	for _, f := range beforeSuiteFuncs {
+		f()
+	}
+
+	code := m.Run()
+
+	for i := len(afterSuiteFuncs) - 1; i >= 0; i-- {
+		afterSuiteFuncs[i]()
+	}
+
+	os.Exit(code)
+}
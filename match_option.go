@@ -0,0 +1,121 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// A MatchOption adjusts how a matcher compares values. Apply options to a
+// matcher with WithOptions.
+type MatchOption interface {
+	apply(o *matchOptions)
+}
+
+// The combined effect of a set of MatchOptions.
+type matchOptions struct {
+	// Ignore struct fields that are unexported.
+	ignoreUnexported bool
+
+	// Ignore struct fields with these types.
+	ignoredTypes map[reflect.Type]bool
+}
+
+// Return true iff the supplied struct field should be ignored.
+func (o *matchOptions) ignoresField(sf reflect.StructField) bool {
+	if o == nil {
+		return false
+	}
+
+	return (o.ignoreUnexported && sf.PkgPath != "") || o.ignoredTypes[sf.Type]
+}
+
+type matchOptionFunc func(o *matchOptions)
+
+func (f matchOptionFunc) apply(o *matchOptions) { f(o) }
+
+// IgnoreUnexportedFields returns an option that causes unexported struct
+// fields to be skipped when comparing structs, at any depth.
+func IgnoreUnexportedFields() MatchOption {
+	return matchOptionFunc(func(o *matchOptions) {
+		o.ignoreUnexported = true
+	})
+}
+
+// IgnoreFieldsOfType returns an option that causes struct fields to be
+// skipped when comparing structs, at any depth, if their type is the type of
+// one of the supplied values.
+//
+// For example:
+//
+//     IgnoreFieldsOfType(time.Time{}, &sync.Mutex{})
+//
+func IgnoreFieldsOfType(types ...interface{}) MatchOption {
+	return matchOptionFunc(func(o *matchOptions) {
+		if o.ignoredTypes == nil {
+			o.ignoredTypes = make(map[reflect.Type]bool)
+		}
+
+		for _, x := range types {
+			o.ignoredTypes[reflect.TypeOf(x)] = true
+		}
+	})
+}
+
+// Implemented by matchers that accept options.
+type optionsMatcher interface {
+	oglematchers.Matcher
+
+	// Return a copy of the matcher that uses the supplied options.
+	withOptions(o *matchOptions) oglematchers.Matcher
+}
+
+// WithOptions returns a copy of m that compares values according to the
+// supplied options. m must be a matcher from this package that accepts
+// options: EqualTo, DeepEqualTo, or MatchStruct, which passes the options on
+// to its field matchers and exempts ignored fields from
+// --ogletest.strict-structs. The matchers in oglematchers, such as Equals and
+// DeepEquals, don't accept options; WithOptions panics if given one of them
+// rather than silently ignoring the options.
+//
+// For example:
+//
+//     ExpectThat(user, WithOptions(
+//       MatchStruct(map[string]interface{}{
+//         "Name":    "jacobsa",
+//         "Profile": Profile{Bio: "taco enthusiast"},
+//       }),
+//       IgnoreUnexportedFields(),
+//       IgnoreFieldsOfType(time.Time{})))
+//
+func WithOptions(
+	m oglematchers.Matcher,
+	opts ...MatchOption) oglematchers.Matcher {
+	om, ok := m.(optionsMatcher)
+	if !ok {
+		panic(fmt.Sprintf("WithOptions: %T does not accept options", m))
+	}
+
+	o := &matchOptions{}
+	for _, opt := range opts {
+		opt.apply(o)
+	}
+
+	return om.withOptions(o)
+}
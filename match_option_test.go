@@ -0,0 +1,174 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/oglematchers"
+)
+
+type optionsProfile struct {
+	Bio     string
+	Updated time.Time
+	cache   int
+}
+
+type optionsUser struct {
+	Name    string
+	Profile optionsProfile
+	Created time.Time
+}
+
+func TestFirstDifferenceWithOptions(t *testing.T) {
+	want := optionsProfile{Bio: "taco", cache: 1}
+	got := optionsProfile{Bio: "taco", Updated: time.Now(), cache: 2}
+
+	if firstDifference(want, got) == "" {
+		t.Errorf("Expected a difference without options.")
+	}
+
+	o := &matchOptions{}
+	IgnoreUnexportedFields().apply(o)
+	IgnoreFieldsOfType(time.Time{}).apply(o)
+
+	expectEqStr(t, "", firstDifferenceWithOptions(want, got, o))
+
+	got.Bio = "burrito"
+	expectEqStr(
+		t,
+		"at got.Bio: want taco, got burrito",
+		firstDifferenceWithOptions(want, got, o))
+}
+
+func TestMatchStructWithOptions(t *testing.T) {
+	user := optionsUser{
+		Name: "jacobsa",
+		Profile: optionsProfile{
+			Bio:     "taco enthusiast",
+			Updated: time.Now(),
+			cache:   17,
+		},
+		Created: time.Now(),
+	}
+
+	m := WithOptions(
+		MatchStruct(map[string]interface{}{
+			"Name":    "jacobsa",
+			"Profile": optionsProfile{Bio: "taco enthusiast"},
+		}),
+		IgnoreUnexportedFields(),
+		IgnoreFieldsOfType(time.Time{}))
+
+	if err := m.Matches(user); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	// Fields of ignored types don't need to be checked in strict mode.
+	*fStrictStructs = true
+	defer func() { *fStrictStructs = false }()
+
+	if err := m.Matches(&user); err != nil {
+		t.Errorf("Unexpected error in strict mode: %v", err)
+	}
+
+	// Differences in the remaining fields are still reported.
+	user.Profile.Bio = "burrito enthusiast"
+	err := m.Matches(user)
+	if err == nil {
+		t.Fatalf("Expected an error.")
+	}
+
+	if !strings.Contains(err.Error(), "which differs at got.Bio") {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestEqualToWithOptions(t *testing.T) {
+	want := optionsProfile{Bio: "taco", cache: 1}
+	got := optionsProfile{Bio: "taco", cache: 2}
+
+	if err := EqualTo(want).Matches(got); err == nil {
+		t.Errorf("Expected a mismatch without options.")
+	}
+
+	m := WithOptions(EqualTo(want), IgnoreUnexportedFields())
+	if err := m.Matches(got); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	got.Bio = "burrito"
+	err := m.Matches(got)
+	if err == nil {
+		t.Fatalf("Expected an error.")
+	}
+
+	expectEqStr(
+		t,
+		"which differs at got.Bio: want taco, got burrito",
+		err.Error())
+
+	// Options don't affect scalars.
+	m = WithOptions(EqualTo(17), IgnoreUnexportedFields())
+	if err := m.Matches(17); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestDeepEqualToWithOptions(t *testing.T) {
+	want := []optionsUser{{Name: "jacobsa"}}
+	got := []optionsUser{{Name: "jacobsa", Created: time.Now()}}
+
+	if err := DeepEqualTo(want).Matches(got); err == nil {
+		t.Errorf("Expected a mismatch without options.")
+	}
+
+	m := WithOptions(DeepEqualTo(want), IgnoreFieldsOfType(time.Time{}))
+	if err := m.Matches(got); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	got[0].Name = "taco"
+	err := m.Matches(got)
+	if err == nil {
+		t.Fatalf("Expected an error.")
+	}
+
+	expectEqStr(
+		t,
+		"which differs at got[0].Name: want jacobsa, got taco",
+		err.Error())
+
+	// Values of other types are not deeply equal.
+	err = m.Matches("taco")
+	if _, ok := err.(*oglematchers.FatalError); !ok {
+		t.Errorf("Expected a fatal error, got %v", err)
+	}
+}
+
+func TestWithOptionsRejectsOtherMatchers(t *testing.T) {
+	defer func() {
+		r, _ := recover().(string)
+		if !strings.HasPrefix(r, "WithOptions: ") {
+			t.Errorf("Unexpected panic: %v", r)
+		}
+	}()
+
+	WithOptions(HasSubstr("taco"), IgnoreUnexportedFields())
+}
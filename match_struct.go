@@ -0,0 +1,218 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// MatchStruct returns a matcher for structs and pointers to structs that
+// checks each of the named fields against the corresponding value in the
+// supplied map. Values that are not matchers are compared as by ExpectEq, or
+// as by EqualTo if options are supplied with WithOptions.
+// Fields not mentioned in the map are not checked, unless the
+// --ogletest.strict-structs flag is set, in which case any exported field
+// that is neither checked nor marked with OmitField causes a mismatch.
+//
+// For example:
+//
+//     ExpectThat(user, MatchStruct(map[string]interface{}{
+//       "Name": "jacobsa",
+//       "Age":  GreaterThan(17),
+//     }))
+//
+func MatchStruct(fields map[string]interface{}) oglematchers.Matcher {
+	m := &structMatcher{
+		fields:  make(map[string]oglematchers.Matcher),
+		values:  make(map[string]interface{}),
+		omitted: make(map[string]bool),
+	}
+
+	for name, v := range fields {
+		if v == OmitField {
+			m.omitted[name] = true
+			continue
+		}
+
+		m.names = append(m.names, name)
+
+		if fm, ok := v.(oglematchers.Matcher); ok {
+			m.fields[name] = fm
+		} else {
+			m.values[name] = v
+		}
+	}
+
+	sort.Strings(m.names)
+	return m
+}
+
+type structMatcher struct {
+	// The names of the fields to check, sorted.
+	names []string
+
+	// Matchers given for fields, and plain values given for others. Matchers
+	// for the latter are built when needed, since they depend on the options.
+	fields map[string]oglematchers.Matcher
+	values map[string]interface{}
+
+	// Fields that were explicitly skipped with OmitField.
+	omitted map[string]bool
+
+	// Options set with WithOptions, or nil.
+	opts *matchOptions
+}
+
+// Apply the options to the matcher itself, to each field matcher that accepts
+// them, and to the comparison of plain values.
+func (m *structMatcher) withOptions(o *matchOptions) oglematchers.Matcher {
+	m2 := *m
+	m2.opts = o
+	m2.fields = make(map[string]oglematchers.Matcher)
+	for name, fm := range m.fields {
+		if om, ok := fm.(optionsMatcher); ok {
+			fm = om.withOptions(o)
+		}
+
+		m2.fields[name] = fm
+	}
+
+	return &m2
+}
+
+// OmitField may be used as a value in the map given to MatchStruct to
+// document that a field is deliberately not checked, as opposed to having
+// been forgotten. Such fields are exempt from --ogletest.strict-structs.
+//
+// For example:
+//
+//     ExpectThat(user, MatchStruct(map[string]interface{}{
+//       "Name":      "jacobsa",
+//       "CreatedAt": OmitField,
+//     }))
+//
+var OmitField interface{} = omitField{}
+
+type omitField struct{}
+
+func (m *structMatcher) Description() string {
+	var parts []string
+	for _, name := range m.names {
+		parts = append(parts, fmt.Sprintf("%s: %s", name, m.fieldMatcher(name).Description()))
+	}
+
+	return fmt.Sprintf("struct with {%s}", strings.Join(parts, ", "))
+}
+
+func (m *structMatcher) Matches(c interface{}) error {
+	v := reflect.ValueOf(c)
+	if v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return oglematchers.NewFatalError("which is not a struct or a pointer to one")
+	}
+
+	// Check each field, collecting a description of each that fails.
+	var failures []string
+	for _, name := range m.names {
+		sf, ok := v.Type().FieldByName(name)
+		if !ok {
+			return oglematchers.NewFatalError(fmt.Sprintf("which has no field %s", name))
+		}
+
+		if sf.PkgPath != "" {
+			return oglematchers.NewFatalError(
+				fmt.Sprintf("whose field %s is unexported", name))
+		}
+
+		fm := m.fieldMatcher(name)
+		x := v.FieldByIndex(sf.Index).Interface()
+
+		if err := fm.Matches(x); err != nil {
+			failures = append(
+				failures,
+				fmt.Sprintf(
+					"%s (expected %s, actual %v%s)",
+					name,
+					fm.Description(),
+					x,
+					relativeClause(fm, x, err)))
+		}
+	}
+
+	var clauses []string
+	if len(failures) != 0 {
+		clauses = append(
+			clauses,
+			fmt.Sprintf("mismatched fields %s", strings.Join(failures, "; ")))
+	}
+
+	// In strict mode, every exported field must be accounted for.
+	if *fStrictStructs {
+		if unchecked := m.uncheckedFields(v.Type()); len(unchecked) != 0 {
+			clauses = append(
+				clauses,
+				fmt.Sprintf("unchecked fields %s", strings.Join(unchecked, ", ")))
+		}
+	}
+
+	if len(clauses) == 0 {
+		return nil
+	}
+
+	return errors.New(fmt.Sprintf("which has %s", strings.Join(clauses, " and ")))
+}
+
+// Return the names of the exported fields of the struct type t that are
+// neither checked nor omitted, in declaration order.
+func (m *structMatcher) uncheckedFields(t reflect.Type) (names []string) {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" || m.omitted[sf.Name] || m.opts.ignoresField(sf) {
+			continue
+		}
+
+		_, hasMatcher := m.fields[sf.Name]
+		_, hasValue := m.values[sf.Name]
+		if !hasMatcher && !hasValue {
+			names = append(names, sf.Name)
+		}
+	}
+
+	return
+}
+
+// Return the matcher for the named field.
+func (m *structMatcher) fieldMatcher(name string) oglematchers.Matcher {
+	if fm, ok := m.fields[name]; ok {
+		return fm
+	}
+
+	v := m.values[name]
+	if m.opts != nil {
+		return newEqualToMatcher(v, m.opts)
+	}
+
+	return equals(v)
+}
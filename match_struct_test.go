@@ -0,0 +1,101 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"testing"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+type matchStructCandidate struct {
+	Name string
+	Age  int
+	city string
+}
+
+func TestMatchStruct(t *testing.T) {
+	m := MatchStruct(map[string]interface{}{
+		"Name": "taco",
+		"Age":  oglematchers.Equals(17),
+	})
+
+	expectEqStr(t, "struct with {Age: 17, Name: taco}", m.Description())
+
+	if err := m.Matches(matchStructCandidate{"taco", 17, "nyc"}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if err := m.Matches(&matchStructCandidate{"taco", 17, "nyc"}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	err := m.Matches(matchStructCandidate{"burrito", 19, "nyc"})
+	if err == nil {
+		t.Fatalf("Expected an error.")
+	}
+
+	expectEqStr(
+		t,
+		"which has mismatched fields "+
+			"Age (expected 17, actual 19); Name (expected taco, actual burrito)",
+		err.Error())
+}
+
+func TestMatchStructUndefined(t *testing.T) {
+	cases := []struct {
+		fields    map[string]interface{}
+		candidate interface{}
+	}{
+		{map[string]interface{}{"Name": "taco"}, "taco"},
+		{map[string]interface{}{"Name": "taco"}, (*matchStructCandidate)(nil)},
+		{map[string]interface{}{"Color": "red"}, matchStructCandidate{}},
+		{map[string]interface{}{"city": "nyc"}, matchStructCandidate{}},
+	}
+
+	for i, c := range cases {
+		err := MatchStruct(c.fields).Matches(c.candidate)
+		if _, ok := err.(*oglematchers.FatalError); !ok {
+			t.Errorf("Case %d: expected a fatal error, got %v", i, err)
+		}
+	}
+}
+
+func TestMatchStructStrict(t *testing.T) {
+	*fStrictStructs = true
+	defer func() { *fStrictStructs = false }()
+
+	candidate := matchStructCandidate{"taco", 17, "nyc"}
+
+	// Age is neither checked nor omitted.
+	err := MatchStruct(map[string]interface{}{"Name": "taco"}).Matches(candidate)
+	if err == nil {
+		t.Fatalf("Expected an error.")
+	}
+
+	expectEqStr(t, "which has unchecked fields Age", err.Error())
+
+	// Omitting it explicitly is fine.
+	m := MatchStruct(map[string]interface{}{
+		"Name": "taco",
+		"Age":  OmitField,
+	})
+
+	expectEqStr(t, "struct with {Name: taco}", m.Description())
+	if err := m.Matches(candidate); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
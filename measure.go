@@ -0,0 +1,107 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Measure calls f the given number of times, recording statistics about how
+// long each call took. When the --ogletest.show-measures flag is set, the
+// statistics are printed at the end of the test, labelled with the supplied
+// name.
+//
+// For example:
+//
+//     Measure("parse", func() { Parse(input) }, 1000)
+//
+// This is meant for keeping an eye on the performance of code being tested,
+// not as a replacement for benchmarks.
+func Measure(name string, f func(), iterations int) {
+	info := currentlyRunningTest
+	if info == nil {
+		panic("Measure: no test info.")
+	}
+
+	if iterations <= 0 {
+		panic(fmt.Sprintf("Measure: invalid iteration count %d", iterations))
+	}
+
+	durations := make([]time.Duration, iterations)
+	for i := range durations {
+		start := time.Now()
+		f()
+		durations[i] = time.Since(start)
+	}
+
+	m := summarizeDurations(name, durations)
+
+	info.mu.Lock()
+	defer info.mu.Unlock()
+	info.measurements = append(info.measurements, m)
+}
+
+// Statistics about the calls made by Measure.
+type measurement struct {
+	name       string
+	iterations int
+	avg        time.Duration
+	min        time.Duration
+	max        time.Duration
+	p99        time.Duration
+}
+
+func (m measurement) String() string {
+	return fmt.Sprintf(
+		"Measured %s: %d iterations, avg %v, min %v, max %v, p99 %v",
+		m.name,
+		m.iterations,
+		m.avg,
+		m.min,
+		m.max,
+		m.p99)
+}
+
+// Compute statistics for the supplied non-empty list of durations, which is
+// sorted in the process.
+func summarizeDurations(
+	name string,
+	durations []time.Duration) (m measurement) {
+	sort.Sort(durationSlice(durations))
+
+	var total time.Duration
+	for _, d := range durations {
+		total += d
+	}
+
+	n := len(durations)
+	m.name = name
+	m.iterations = n
+	m.avg = total / time.Duration(n)
+	m.min = durations[0]
+	m.max = durations[n-1]
+	m.p99 = durations[(99*n+99)/100-1]
+
+	return
+}
+
+type durationSlice []time.Duration
+
+func (s durationSlice) Len() int           { return len(s) }
+func (s durationSlice) Less(i, j int) bool { return s[i] < s[j] }
+func (s durationSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
@@ -0,0 +1,47 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSummarizeDurations(t *testing.T) {
+	var durations []time.Duration
+	for i := 200; i > 0; i-- {
+		durations = append(durations, time.Duration(i)*time.Millisecond)
+	}
+
+	m := summarizeDurations("taco", durations)
+
+	expectEqStr(
+		t,
+		"Measured taco: 200 iterations, avg 100.5ms, min 1ms, max 200ms, "+
+			"p99 198ms",
+		m.String())
+}
+
+func TestMeasureRecordsMeasurement(t *testing.T) {
+	setUpCurrentTest()
+
+	calls := 0
+	Measure("taco", func() { calls++ }, 3)
+
+	expectEqInt(t, 3, calls)
+	assertEqInt(t, 1, len(currentlyRunningTest.measurements))
+	expectEqStr(t, "taco", currentlyRunningTest.measurements[0].name)
+}
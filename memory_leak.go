@@ -0,0 +1,88 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// ExpectNoMemoryLeak calls fn the supplied number of times, adding a failure
+// record to the currently running test if the heap in use grew by more than
+// threshold bytes across the calls. A garbage collection is forced before
+// each measurement, so only memory that fn has left reachable counts. Extra
+// parameters are treated as in ExpectThat.
+//
+// For example:
+//
+//     ExpectNoMemoryLeak(
+//       func() { machine.Step() },
+//       1000,
+//       1<<20)
+//
+func ExpectNoMemoryLeak(
+	fn func(),
+	iterations int,
+	threshold uint64,
+	errorParts ...interface{}) {
+	expectNoMemoryLeak(fn, iterations, threshold, 1, errorParts)
+}
+
+// The generalized form of ExpectNoMemoryLeak. depth is the distance on the
+// stack between the caller's frame and the user's frame. Returns passed iff
+// the heap grew by no more than threshold bytes.
+func expectNoMemoryLeak(
+	fn func(),
+	iterations int,
+	threshold uint64,
+	depth int,
+	errorParts []interface{}) (passed bool) {
+	before := heapInUse()
+	for i := 0; i < iterations; i++ {
+		fn()
+	}
+
+	after := heapInUse()
+
+	var growth uint64
+	if after > before {
+		growth = after - before
+	}
+
+	if growth <= threshold {
+		passed = true
+		return
+	}
+
+	msg := fmt.Sprintf(
+		"Expected: heap growth of at most %d bytes over %d iterations\n"+
+			"Actual:   %d bytes",
+		threshold,
+		iterations,
+		growth)
+
+	recordFailure(depth+1, msg, errorParts)
+	return
+}
+
+// Return the number of bytes in in-use heap spans after a garbage collection.
+func heapInUse() uint64 {
+	runtime.GC()
+
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	return stats.HeapInuse
+}
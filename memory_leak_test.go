@@ -0,0 +1,51 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"strings"
+	"testing"
+)
+
+var leakedSlices [][]byte
+
+func TestExpectNoMemoryLeak(t *testing.T) {
+	setUpCurrentTest()
+
+	// Garbage is not a leak.
+	ExpectNoMemoryLeak(
+		func() { _ = make([]byte, 1<<16) },
+		100,
+		1<<20)
+
+	assertEqInt(t, 0, len(currentlyRunningTest.failureRecords))
+
+	// Memory that remains reachable is.
+	defer func() { leakedSlices = nil }()
+	ExpectNoMemoryLeak(
+		func() { leakedSlices = append(leakedSlices, make([]byte, 1<<16)) },
+		100,
+		1<<20)
+
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+	record := currentlyRunningTest.failureRecords[0]
+
+	expectEqStr(t, "memory_leak_test.go", record.FileName)
+	expected := "Expected: heap growth of at most 1048576 bytes over 100 iterations"
+	if !strings.HasPrefix(record.Error, expected+"\nActual:   ") {
+		t.Errorf("Unexpected error: %q", record.Error)
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/jacobsa/oglematchers"
+	"github.com/jacobsa/oglemock"
+)
+
+// MockCalls describes the calls made to a particular method of a mock object
+// during the currently running test. See CallsTo.
+type MockCalls struct {
+	// A description of the mock object, as returned by its
+	// Oglemock_Description method.
+	Object string
+
+	// The name of the method.
+	Method string
+
+	// The number of times the method had been called at the time the struct
+	// was created.
+	Count int
+}
+
+func (c MockCalls) String() string {
+	return fmt.Sprintf("%s.%s", c.Object, c.Method)
+}
+
+// CallsTo returns a record of the calls made so far to the named method of
+// the supplied mock object by the currently running test, for use with
+// WasCalled. Only calls made through the test's MockController are seen.
+//
+// For example:
+//
+//     mockWriter := mock_io.NewMockWriter(ti.MockController, "writer")
+//     [...]
+//     ExpectThat(CallsTo(mockWriter, "Write"), WasCalled(2))
+//
+func CallsTo(o oglemock.MockObject, method string) MockCalls {
+	info := currentlyRunningTest
+	if info == nil {
+		panic("CallsTo: no test info.")
+	}
+
+	return MockCalls{
+		Object: o.Oglemock_Description(),
+		Method: method,
+		Count:  info.mockCalls.callCount(o, method),
+	}
+}
+
+// CallCount returns the number of calls made so far to the named method of the
+// supplied mock object by the test, without any expectation having been set
+// up beforehand. As with CallsTo, only calls made through the test's
+// MockController are seen.
+//
+// For example:
+//
+//     cache.Get("taco")
+//     cache.Get("taco")
+//     ExpectEq(1, ti.CallCount(mockBackend, "Fetch"))
+//
+func (info *TestInfo) CallCount(o oglemock.MockObject, method string) int {
+	return info.mockCalls.callCount(o, method)
+}
+
+// WasCalled returns a matcher for MockCalls values (see CallsTo) that matches
+// when the method was called exactly the given number of times.
+func WasCalled(times int) oglematchers.Matcher {
+	return &wasCalledMatcher{times}
+}
+
+type wasCalledMatcher struct {
+	times int
+}
+
+func (m *wasCalledMatcher) Description() string {
+	return fmt.Sprintf("called %s", formatTimes(m.times))
+}
+
+func (m *wasCalledMatcher) Matches(c interface{}) error {
+	calls, ok := c.(MockCalls)
+	if !ok {
+		return oglematchers.NewFatalError("which is not a MockCalls value")
+	}
+
+	if calls.Count == m.times {
+		return nil
+	}
+
+	return errors.New(fmt.Sprintf("which was called %s", formatTimes(calls.Count)))
+}
+
+func formatTimes(n int) string {
+	if n == 1 {
+		return "once"
+	}
+
+	return fmt.Sprintf("%d times", n)
+}
+
+////////////////////////////////////////////////////////////////////////
+// recordingController
+////////////////////////////////////////////////////////////////////////
+
+// A mock object method, identified by the object's ID and the method name.
+type mockMethod struct {
+	id   uintptr
+	name string
+}
+
+// recordingController is an oglemock.Controller that keeps track of the method
+// calls it handles before passing everything on to a wrapped controller. Each
+// call is assigned a sequence ID, so that errors reported while handling it
+// can be correlated with the order of calls.
+type recordingController struct {
+	wrapped oglemock.Controller
+
+	// The source of sequence IDs, shared with any other controllers for the
+	// same test so that IDs are unique across them. Accessed atomically.
+	seq *uint64
+
+	// Held while the wrapped controller handles a call, so that any error it
+	// reports can be attributed to that call. The wrapped controller serializes
+	// calls anyway, so this costs nothing in concurrency.
+	handleMu sync.Mutex
+
+	mu sync.Mutex
+
+	// The number of calls handled for each method.
+	//
+	// GUARDED_BY(mu)
+	calls map[mockMethod]int
+
+	// The sequence ID of the call currently being handled, or zero if none.
+	//
+	// GUARDED_BY(mu)
+	current uint64
+}
+
+func newRecordingController(
+	wrapped oglemock.Controller,
+	seq *uint64) *recordingController {
+	return &recordingController{
+		wrapped: wrapped,
+		seq:     seq,
+		calls:   make(map[mockMethod]int),
+	}
+}
+
+func (c *recordingController) callCount(
+	o oglemock.MockObject,
+	methodName string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.calls[mockMethod{o.Oglemock_Id(), methodName}]
+}
+
+// Return the sequence ID of the call currently being handled, or zero if
+// none.
+func (c *recordingController) currentCall() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.current
+}
+
+func (c *recordingController) ExpectCall(
+	o oglemock.MockObject,
+	methodName string,
+	fileName string,
+	lineNumber int) oglemock.PartialExpecation {
+	return c.wrapped.ExpectCall(o, methodName, fileName, lineNumber)
+}
+
+func (c *recordingController) Finish() {
+	c.wrapped.Finish()
+}
+
+func (c *recordingController) HandleMethodCall(
+	o oglemock.MockObject,
+	methodName string,
+	fileName string,
+	lineNumber int,
+	args []interface{}) []interface{} {
+	c.handleMu.Lock()
+	defer c.handleMu.Unlock()
+
+	c.mu.Lock()
+	c.calls[mockMethod{o.Oglemock_Id(), methodName}]++
+	c.current = atomic.AddUint64(c.seq, 1)
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		c.current = 0
+		c.mu.Unlock()
+	}()
+
+	return c.wrapped.HandleMethodCall(o, methodName, fileName, lineNumber, args)
+}
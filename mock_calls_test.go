@@ -0,0 +1,127 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jacobsa/oglemock"
+)
+
+type fakeMockObject struct {
+	id uintptr
+}
+
+func (o *fakeMockObject) Oglemock_Id() uintptr {
+	return o.id
+}
+
+func (o *fakeMockObject) Oglemock_Description() string {
+	return "taco"
+}
+
+type fakeController struct {
+	oglemock.Controller
+	handled int
+}
+
+func (c *fakeController) HandleMethodCall(
+	o oglemock.MockObject,
+	methodName string,
+	fileName string,
+	lineNumber int,
+	args []interface{}) []interface{} {
+	c.handled++
+	return nil
+}
+
+func TestWasCalled(t *testing.T) {
+	setUpCurrentTest()
+	wrapped := &fakeController{}
+	currentlyRunningTest.mockCalls = newRecordingController(wrapped, new(uint64))
+
+	o1 := &fakeMockObject{1}
+	o2 := &fakeMockObject{2}
+	c := currentlyRunningTest.mockCalls
+	c.HandleMethodCall(o1, "Foo", "", 0, nil)
+	c.HandleMethodCall(o1, "Foo", "", 0, nil)
+	c.HandleMethodCall(o1, "Bar", "", 0, nil)
+	c.HandleMethodCall(o2, "Foo", "", 0, nil)
+
+	expectEqInt(t, 4, wrapped.handled)
+
+	if err := WasCalled(2).Matches(CallsTo(o1, "Foo")); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	err := WasCalled(2).Matches(CallsTo(o2, "Foo"))
+	if err == nil {
+		t.Fatalf("Expected an error.")
+	}
+
+	expectEqStr(t, "which was called once", err.Error())
+	expectEqStr(t, "called 2 times", WasCalled(2).Description())
+
+	expectEqInt(t, 2, currentlyRunningTest.CallCount(o1, "Foo"))
+	expectEqInt(t, 1, currentlyRunningTest.CallCount(o1, "Bar"))
+	expectEqInt(t, 0, currentlyRunningTest.CallCount(o2, "Bar"))
+}
+
+// A controller that reports an error for every call to the method "Bad".
+type complainingController struct {
+	oglemock.Controller
+	reporter oglemock.ErrorReporter
+}
+
+func (c *complainingController) HandleMethodCall(
+	o oglemock.MockObject,
+	methodName string,
+	fileName string,
+	lineNumber int,
+	args []interface{}) []interface{} {
+	if methodName == "Bad" {
+		c.reporter.ReportError(fileName, lineNumber, errors.New("taco"))
+	}
+
+	return nil
+}
+
+func TestMockCallSequenceIDs(t *testing.T) {
+	setUpCurrentTest()
+	info := currentlyRunningTest
+
+	reporter := &testInfoErrorReporter{testInfo: info}
+	c := newRecordingController(
+		&complainingController{reporter: reporter},
+		&info.mockCallSeq)
+
+	reporter.calls = c
+
+	o := &fakeMockObject{1}
+	c.HandleMethodCall(o, "Good", "foo.go", 17, nil)
+	c.HandleMethodCall(o, "Bad", "foo.go", 19, nil)
+	c.HandleMethodCall(o, "Good", "foo.go", 23, nil)
+	c.HandleMethodCall(o, "Bad", "foo.go", 29, nil)
+
+	// Errors reported outside of a call have no ID.
+	reporter.ReportError("foo.go", 31, errors.New("burrito"))
+
+	assertEqInt(t, 3, len(info.failureRecords))
+	expectEqStr(t, "Mock call #2: taco", info.failureRecords[0].Error)
+	expectEqStr(t, "Mock call #4: taco", info.failureRecords[1].Error)
+	expectEqStr(t, "burrito", info.failureRecords[2].Error)
+}
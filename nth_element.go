@@ -0,0 +1,96 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// FirstElement returns a matcher that matches non-empty slices and arrays
+// whose first element is matched by inner. For example:
+//
+//     ExpectThat(events, FirstElement(Equals("start")))
+//
+func FirstElement(inner oglematchers.Matcher) oglematchers.Matcher {
+	return &nthElementMatcher{inner: inner, n: 0, which: "first element"}
+}
+
+// LastElement is like FirstElement, but applies inner to the last element.
+func LastElement(inner oglematchers.Matcher) oglematchers.Matcher {
+	return &nthElementMatcher{inner: inner, n: -1, which: "last element"}
+}
+
+// NthElement returns a matcher that matches slices and arrays whose element
+// at index n is matched by inner. Negative indices count from the end, so -1
+// refers to the last element. The matcher is undefined for candidates too
+// short to have such an element.
+func NthElement(n int, inner oglematchers.Matcher) oglematchers.Matcher {
+	return &nthElementMatcher{
+		inner: inner,
+		n:     n,
+		which: fmt.Sprintf("element %d", n),
+	}
+}
+
+type nthElementMatcher struct {
+	inner oglematchers.Matcher
+	n     int
+
+	// A description of the element, e.g. "first element" or "element 2".
+	which string
+}
+
+func (m *nthElementMatcher) Description() string {
+	return fmt.Sprintf("%s %s", m.which, m.inner.Description())
+}
+
+func (m *nthElementMatcher) Matches(c interface{}) error {
+	v := reflect.ValueOf(c)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return oglematchers.NewFatalError("which is not a slice or array")
+	}
+
+	i := m.n
+	if i < 0 {
+		i += v.Len()
+	}
+
+	if i < 0 || i >= v.Len() {
+		return oglematchers.NewFatalError(
+			fmt.Sprintf("which has length %d", v.Len()))
+	}
+
+	e := v.Index(i).Interface()
+	err := m.inner.Matches(e)
+	if err == nil {
+		return nil
+	}
+
+	msg := fmt.Sprintf("whose %s is %v", m.which, e)
+	if err.Error() != "" {
+		msg += ", " + err.Error()
+	}
+
+	if _, ok := err.(*oglematchers.FatalError); ok {
+		return oglematchers.NewFatalError(msg)
+	}
+
+	return errors.New(msg)
+}
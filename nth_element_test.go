@@ -0,0 +1,96 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"testing"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+func TestFirstElement(t *testing.T) {
+	m := FirstElement(oglematchers.Equals("taco"))
+	expectEqStr(t, "first element taco", m.Description())
+
+	if err := m.Matches([]string{"taco", "burrito"}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	err := m.Matches([]string{"burrito", "taco"})
+	if err == nil {
+		t.Fatalf("Expected an error.")
+	}
+
+	expectEqStr(t, "whose first element is burrito", err.Error())
+}
+
+func TestLastElement(t *testing.T) {
+	m := LastElement(oglematchers.Equals("taco"))
+	expectEqStr(t, "last element taco", m.Description())
+
+	if err := m.Matches([2]string{"burrito", "taco"}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	err := m.Matches([]string{"taco", "burrito"})
+	if err == nil {
+		t.Fatalf("Expected an error.")
+	}
+
+	expectEqStr(t, "whose last element is burrito", err.Error())
+}
+
+func TestFirstElementUndefined(t *testing.T) {
+	m := FirstElement(oglematchers.Equals("taco"))
+
+	for _, c := range []interface{}{[]string{}, "taco", nil} {
+		err := m.Matches(c)
+		if _, ok := err.(*oglematchers.FatalError); !ok {
+			t.Errorf("Expected a fatal error for %#v, got %v", c, err)
+		}
+	}
+}
+
+func TestNthElement(t *testing.T) {
+	candidate := []string{"taco", "burrito", "enchilada"}
+
+	m := NthElement(1, oglematchers.Equals("burrito"))
+	expectEqStr(t, "element 1 burrito", m.Description())
+
+	if err := m.Matches(candidate); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	err := NthElement(-2, oglematchers.Equals("taco")).Matches(candidate)
+	if err == nil {
+		t.Fatalf("Expected an error.")
+	}
+
+	expectEqStr(t, "whose element -2 is burrito", err.Error())
+}
+
+func TestNthElementOutOfBounds(t *testing.T) {
+	candidate := []string{"taco", "burrito"}
+
+	for _, n := range []int{2, -3} {
+		err := NthElement(n, oglematchers.Equals("taco")).Matches(candidate)
+		if _, ok := err.(*oglematchers.FatalError); !ok {
+			t.Fatalf("Expected a fatal error for %d, got %v", n, err)
+		}
+
+		expectEqStr(t, "which has length 2", err.Error())
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// NumberOf returns a matcher that matches slices and arrays containing exactly
+// count elements matched by inner. For example:
+//
+//     ExpectThat(users, NumberOf(3, HasSubstr("admin")))
+//
+func NumberOf(count int, inner oglematchers.Matcher) oglematchers.Matcher {
+	return &numberOfMatcher{
+		inner:    inner,
+		relation: "exactly",
+		n:        count,
+		ok:       func(k int) bool { return k == count },
+	}
+}
+
+// AtLeastOf is like NumberOf, but matches when at least n elements are
+// matched by inner.
+func AtLeastOf(n int, inner oglematchers.Matcher) oglematchers.Matcher {
+	return &numberOfMatcher{
+		inner:    inner,
+		relation: "at least",
+		n:        n,
+		ok:       func(k int) bool { return k >= n },
+	}
+}
+
+// AtMostOf is like NumberOf, but matches when at most n elements are matched
+// by inner.
+func AtMostOf(n int, inner oglematchers.Matcher) oglematchers.Matcher {
+	return &numberOfMatcher{
+		inner:    inner,
+		relation: "at most",
+		n:        n,
+		ok:       func(k int) bool { return k <= n },
+	}
+}
+
+type numberOfMatcher struct {
+	inner    oglematchers.Matcher
+	relation string
+	n        int
+	ok       func(int) bool
+}
+
+func (m *numberOfMatcher) Description() string {
+	return fmt.Sprintf(
+		"%s %d elements that %s",
+		m.relation,
+		m.n,
+		m.inner.Description())
+}
+
+func (m *numberOfMatcher) Matches(c interface{}) error {
+	v := reflect.ValueOf(c)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return oglematchers.NewFatalError("which is not a slice or array")
+	}
+
+	// Count the matching elements. Elements for which the inner matcher is
+	// undefined simply don't count.
+	count := 0
+	for i := 0; i < v.Len(); i++ {
+		if m.inner.Matches(v.Index(i).Interface()) == nil {
+			count++
+		}
+	}
+
+	if m.ok(count) {
+		return nil
+	}
+
+	if count == 1 {
+		return errors.New("which has 1 matching element")
+	}
+
+	return errors.New(fmt.Sprintf("which has %d matching elements", count))
+}
@@ -0,0 +1,71 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"testing"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+func TestNumberOf(t *testing.T) {
+	candidate := []string{"taco", "burrito", "taco", "enchilada"}
+
+	m := NumberOf(2, oglematchers.Equals("taco"))
+	expectEqStr(t, "exactly 2 elements that taco", m.Description())
+
+	if err := m.Matches(candidate); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	err := NumberOf(1, oglematchers.Equals("taco")).Matches(candidate)
+	if err == nil {
+		t.Fatalf("Expected an error.")
+	}
+
+	expectEqStr(t, "which has 2 matching elements", err.Error())
+}
+
+func TestAtLeastAndAtMostOf(t *testing.T) {
+	candidate := [3]string{"taco", "burrito", "taco"}
+	inner := oglematchers.Equals("taco")
+
+	if err := AtLeastOf(2, inner).Matches(candidate); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if err := AtLeastOf(3, inner).Matches(candidate); err == nil {
+		t.Errorf("Expected an error.")
+	}
+
+	if err := AtMostOf(2, inner).Matches(candidate); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	err := AtMostOf(1, inner).Matches(candidate)
+	if err == nil {
+		t.Fatalf("Expected an error.")
+	}
+
+	expectEqStr(t, "which has 2 matching elements", err.Error())
+}
+
+func TestNumberOfWrongCandidateType(t *testing.T) {
+	err := NumberOf(1, oglematchers.Equals("taco")).Matches("taco")
+	if _, ok := err.(*oglematchers.FatalError); !ok {
+		t.Errorf("Expected a fatal error, got %v", err)
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Result is the outcome of a single test function, as reported to a
+// Formatter.
+type Result int
+
+const (
+	ResultPassed Result = iota
+	ResultFailed
+
+	// Not run because other tests have been focused.
+	ResultSkipped
+
+	// Named like PendingFoo, and so not run.
+	ResultPending
+)
+
+func (r Result) String() string {
+	switch r {
+	case ResultPassed:
+		return "passed"
+
+	case ResultFailed:
+		return "failed"
+
+	case ResultSkipped:
+		return "skipped"
+
+	case ResultPending:
+		return "pending"
+	}
+
+	return fmt.Sprintf("Result(%d)", int(r))
+}
+
+// A Formatter produces the output for a run by a SuiteRunner created with
+// WithFormatter, in place of ogletest's usual per-test banners and end of run
+// summary.
+type Formatter interface {
+	// Called once for each test function considered by the run, with its full
+	// name (e.g. "FooTest.DoesBar") and outcome. For a failed test, failures
+	// are the failures from its final attempt.
+	TestFinished(name string, r Result, failures []FailureRecord)
+
+	// Called once when the run has finished.
+	RunFinished()
+}
+
+// The character printed for each result by --ogletest.dots.
+var resultDots = map[Result]string{
+	ResultPassed:  ".",
+	ResultFailed:  "F",
+	ResultSkipped: "S",
+	ResultPending: "P",
+}
+
+// Counts of test results, along with failures whose printing has been
+// deferred until the end of the run. Used when per-test output is
+// suppressed.
+type runStats struct {
+	start  time.Time
+	counts map[Result]int
+
+	// Failures to print at the end, with the test names they belong to.
+	failedTests []string
+	failures    [][]FailureRecord
+
+	// Set if tests were left unrun because of --ogletest.max-failures.
+	hitMaxFailures bool
+
+	// If non-nil, the Formatter to which output for the run is delegated.
+	formatter Formatter
+}
+
+// Statistics for the current run. Reset by resetStats.
+var gStats runStats
+
+func resetStats() {
+	gStats = runStats{
+		start:  time.Now(),
+		counts: make(map[Result]int),
+	}
+}
+
+// Return true iff per-test output is to be suppressed in favor of a summary at
+// the end of the run, or because a Formatter is producing the output.
+func quietOutput() bool {
+	return *fDots || *fSummaryOnly || gStats.formatter != nil
+}
+
+// Print the supplied banner line, unless per-test output has been
+// suppressed.
+func printBanner(format string, v ...interface{}) {
+	if quietOutput() {
+		return
+	}
+
+	fmt.Printf(format, v...)
+}
+
+// Record the result of the named test, printing progress if appropriate.
+// failures are the failures for a failed test, which are printed at the end
+// of the run if per-test output has been suppressed.
+func noteResult(
+	name string,
+	r Result,
+	failures []FailureRecord) {
+	if gStats.counts == nil {
+		resetStats()
+	}
+
+	gStats.counts[r]++
+
+	if gStats.formatter != nil {
+		gStats.formatter.TestFinished(name, r, failures)
+		return
+	}
+
+	if !quietOutput() {
+		return
+	}
+
+	if len(failures) != 0 {
+		gStats.failedTests = append(gStats.failedTests, name)
+		gStats.failures = append(gStats.failures, failures)
+	}
+
+	if !*fSummaryOnly {
+		fmt.Print(Colorize(resultDots[r], resultColor(r)))
+	}
+}
+
+func resultColor(r Result) string {
+	switch r {
+	case ResultPassed:
+		return colorGreen
+
+	case ResultFailed:
+		return colorRed
+	}
+
+	return colorYellow
+}
+
+// Note if the run was cut short by --ogletest.max-failures. Then, if per-test
+// output has been suppressed, print the deferred failures and a summary of
+// the run, or tell the Formatter that the run has finished.
+func printSummary() {
+	if gStats.hitMaxFailures {
+		fmt.Printf(
+			"%s Stopped after %d failed tests (--ogletest.max-failures=%d)\n",
+			Colorize("[----------]", colorRed),
+			gStats.counts[ResultFailed],
+			*fMaxFailures)
+	}
+
+	if gStats.formatter != nil {
+		gStats.formatter.RunFinished()
+		return
+	}
+
+	if !quietOutput() {
+		return
+	}
+
+	if gStats.counts == nil {
+		resetStats()
+	}
+
+	// Finish the line of dots.
+	if !*fSummaryOnly {
+		fmt.Println()
+	}
+
+	for i, name := range gStats.failedTests {
+		fmt.Printf("%s %s\n", Colorize("[  FAILED  ]", colorRed), name)
+		printFailures(gStats.failures[i])
+	}
+
+	c := gStats.counts
+	parts := []string{
+		fmt.Sprintf("%d passed", c[ResultPassed]),
+		fmt.Sprintf("%d failed", c[ResultFailed]),
+	}
+
+	if c[ResultSkipped] > 0 {
+		parts = append(parts, fmt.Sprintf("%d skipped", c[ResultSkipped]))
+	}
+
+	if c[ResultPending] > 0 {
+		parts = append(parts, fmt.Sprintf("%d pending", c[ResultPending]))
+	}
+
+	total := 0
+	for _, n := range c {
+		total += n
+	}
+
+	fmt.Printf(
+		"Ran %d tests in %.1fs: %s\n",
+		total,
+		time.Since(gStats.start).Seconds(),
+		strings.Join(parts, ", "))
+}
@@ -0,0 +1,7 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+
+// Matchers for protocol buffer messages. These live in their own package so
+// that users of ogletest who don't use protocol buffers don't need to have the
+// protobuf runtime installed.
+package protomatchers
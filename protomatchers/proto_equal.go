@@ -0,0 +1,60 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protomatchers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jacobsa/oglematchers"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+// ProtoEqual returns a matcher that matches protocol buffer messages that are
+// equal to the supplied message according to proto.Equal. This is useful
+// because generated message structs contain unexported bookkeeping fields
+// that make DeepEquals unreliable.
+//
+// Candidates that are not protocol buffer messages cause a fatal error.
+func ProtoEqual(expected proto.Message) oglematchers.Matcher {
+	if expected == nil {
+		panic("ProtoEqual: expected message must be non-nil.")
+	}
+
+	return &protoEqualMatcher{expected}
+}
+
+type protoEqualMatcher struct {
+	expected proto.Message
+}
+
+func (m *protoEqualMatcher) Description() string {
+	return fmt.Sprintf("proto equal to {%s}", prototext.Format(m.expected))
+}
+
+func (m *protoEqualMatcher) Matches(c interface{}) error {
+	msg, ok := c.(proto.Message)
+	if !ok {
+		return oglematchers.NewFatalError("which is not a proto message")
+	}
+
+	if proto.Equal(m.expected, msg) {
+		return nil
+	}
+
+	return errors.New(fmt.Sprintf("which is {%s}", prototext.Format(msg)))
+}
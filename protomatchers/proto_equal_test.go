@@ -0,0 +1,75 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protomatchers_test
+
+import (
+	"testing"
+
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+	"github.com/jacobsa/ogletest/protomatchers"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestProtoEqualTest(t *testing.T) { RunTests(t) }
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+type ProtoEqualTest struct {
+}
+
+func init() { RegisterTestSuite(&ProtoEqualTest{}) }
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func (t *ProtoEqualTest) MatchingMessage() {
+	m := protomatchers.ProtoEqual(wrapperspb.String("taco"))
+
+	// A distinct but equal message matches.
+	ExpectEq(nil, m.Matches(wrapperspb.String("taco")))
+}
+
+func (t *ProtoEqualTest) MismatchingMessage() {
+	m := protomatchers.ProtoEqual(wrapperspb.String("taco"))
+	ExpectThat(m.Description(), HasSubstr("proto equal to {"))
+	ExpectThat(m.Description(), HasSubstr(`"taco"`))
+
+	err := m.Matches(wrapperspb.String("burrito"))
+	AssertNe(nil, err)
+
+	_, isFatal := err.(*FatalError)
+	ExpectFalse(isFatal)
+
+	// The text format output contains randomized whitespace, so only check the
+	// important parts.
+	ExpectThat(err.Error(), HasSubstr("which is {"))
+	ExpectThat(err.Error(), HasSubstr(`"burrito"`))
+}
+
+func (t *ProtoEqualTest) NonProtoCandidate() {
+	m := protomatchers.ProtoEqual(wrapperspb.String("taco"))
+
+	err := m.Matches("taco")
+	AssertNe(nil, err)
+
+	_, isFatal := err.(*FatalError)
+	ExpectTrue(isFatal)
+	ExpectEq("which is not a proto message", err.Error())
+}
@@ -15,6 +15,11 @@
 
 package ogletest
 
+import (
+	"sync"
+	"sync/atomic"
+)
+
 // The input to ogletest.Register. Most users will want to use
 // ogletest.RegisterTestSuite.
 //
@@ -35,6 +40,11 @@ type TestSuite struct {
 	// If non-nil, a function that will be run exactly once, after all of the
 	// test functions have run.
 	TearDown func()
+
+	// Optional metadata about the suite, such as its owner, for the benefit of
+	// tools that process test output. Annotations are printed alongside the
+	// suite's name when it is run.
+	Annotations map[string]string
 }
 
 type TestFunction struct {
@@ -63,7 +73,14 @@ type TestFunction struct {
 //
 // Panics on invalid input.
 func Register(suite TestSuite) {
-	// Make sure the suite is legal.
+	checkTestSuite(suite)
+
+	// Save the suite for later.
+	registeredSuites = append(registeredSuites, suite)
+}
+
+// Panic if the supplied suite is not legal.
+func checkTestSuite(suite TestSuite) {
 	if suite.Name == "" {
 		panic("Test suites must have names.")
 	}
@@ -77,10 +94,80 @@ func Register(suite TestSuite) {
 			panic("Test functions must have non-nil run fields.")
 		}
 	}
-
-	// Save the suite for later.
-	registeredSuites = append(registeredSuites, suite)
 }
 
 // The list of test suites previously registered.
 var registeredSuites []TestSuite
+
+// Information about a registered test suite, as returned by Suites.
+type SuiteInfo struct {
+	// The name of the suite, e.g. "FooTest".
+	Name string
+
+	// The names of the suite's test functions, in the order they will be run,
+	// e.g. "DoesBar".
+	Methods []string
+}
+
+// Suites returns information about the test suites registered so far, in the
+// order they will be run, for the benefit of tools that want to list tests
+// without running them. Filtering flags such as --ogletest.run are not
+// applied.
+func Suites() []SuiteInfo {
+	infos := make([]SuiteInfo, len(registeredSuites))
+	for i, suite := range registeredSuites {
+		infos[i].Name = suite.Name
+		for _, tf := range suite.TestFunctions {
+			infos[i].Methods = append(infos[i].Methods, tf.Name)
+		}
+	}
+
+	return infos
+}
+
+// RegisterGlobalSetUp registers a function to be run by RunTests once, before
+// any test suite is set up. This is useful for infrastructure shared by
+// several suites, like a test database. Functions are run in the order they
+// were registered.
+//
+// To run a function before every test in the package, not just those run by
+// RunTests, use BeforeSuite and Main instead.
+func RegisterGlobalSetUp(f func()) {
+	globalSetUps = append(globalSetUps, f)
+}
+
+// RegisterGlobalTearDown registers a function to be run by RunTests once,
+// after the last test suite has been torn down. Functions are run in the
+// reverse of the order they were registered.
+//
+// Functions registered with AfterSuite are run later, by Main once all tests
+// in the package have finished.
+func RegisterGlobalTearDown(f func()) {
+	globalTearDowns = append(globalTearDowns, f)
+}
+
+// Functions previously registered with RegisterGlobalSetUp and
+// RegisterGlobalTearDown.
+var globalSetUps []func()
+var globalTearDowns []func()
+
+// ResetSuites forgets all registered test suites, global set-up and tear-down
+// functions, and functions registered with BeforeSuite and AfterSuite, and
+// allows RunTests to run again. This is useful for test binaries that call
+// RunTests more than once, for example from TestMain with different
+// configurations, re-registering suites before each call. Note that Main
+// doesn't run functions that were registered with AfterSuite before a call to
+// ResetSuites.
+//
+// ResetSuites must not be called while tests are running.
+func ResetSuites() {
+	registeredSuites = nil
+	globalSetUps = nil
+	globalTearDowns = nil
+	beforeSuiteFuncs = nil
+	afterSuiteFuncs = nil
+
+	runTestsOnce = sync.Once{}
+	atomic.StoreUint64(&gStopRunning, 0)
+	resetStats()
+}
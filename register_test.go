@@ -0,0 +1,118 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import "testing"
+
+func TestSuites(t *testing.T) {
+	saved := registeredSuites
+	defer func() { registeredSuites = saved }()
+
+	registeredSuites = nil
+	Register(TestSuite{
+		Name: "FooTest",
+		TestFunctions: []TestFunction{
+			{Name: "DoesBar", Run: func() {}},
+			{Name: "DoesBaz", Run: func() {}},
+		},
+	})
+
+	RegisterTestSuite(&annotatedSuite{})
+
+	infos := Suites()
+	assertEqInt(t, 2, len(infos))
+
+	expectEqStr(t, "FooTest", infos[0].Name)
+	assertEqInt(t, 2, len(infos[0].Methods))
+	expectEqStr(t, "DoesBar", infos[0].Methods[0])
+	expectEqStr(t, "DoesBaz", infos[0].Methods[1])
+
+	expectEqStr(t, "annotatedSuite", infos[1].Name)
+	assertEqInt(t, 1, len(infos[1].Methods))
+	expectEqStr(t, "SomeTest", infos[1].Methods[0])
+}
+
+func TestResetSuites(t *testing.T) {
+	savedSuites := registeredSuites
+	savedSetUps := globalSetUps
+	savedTearDowns := globalTearDowns
+	savedBeforeSuite := beforeSuiteFuncs
+	savedAfterSuite := afterSuiteFuncs
+	defer func() {
+		registeredSuites = savedSuites
+		globalSetUps = savedSetUps
+		globalTearDowns = savedTearDowns
+		beforeSuiteFuncs = savedBeforeSuite
+		afterSuiteFuncs = savedAfterSuite
+	}()
+
+	RegisterTestSuite(&annotatedSuite{})
+	RegisterGlobalSetUp(func() {})
+	RegisterGlobalTearDown(func() {})
+	BeforeSuite(func() {})
+	AfterSuite(func() {})
+	runTestsOnce.Do(func() {})
+
+	ResetSuites()
+
+	expectEqInt(t, 0, len(Suites()))
+	expectEqInt(t, 0, len(globalSetUps))
+	expectEqInt(t, 0, len(globalTearDowns))
+	expectEqInt(t, 0, len(beforeSuiteFuncs))
+	expectEqInt(t, 0, len(afterSuiteFuncs))
+
+	// RunTests should be able to run again.
+	ran := false
+	runTestsOnce.Do(func() { ran = true })
+	if !ran {
+		t.Errorf("runTestsOnce was not reset.")
+	}
+}
+
+func TestGlobalSetUpAndTearDownOrder(t *testing.T) {
+	savedSuites := registeredSuites
+	savedSetUps := globalSetUps
+	savedTearDowns := globalTearDowns
+	defer func() {
+		registeredSuites = savedSuites
+		globalSetUps = savedSetUps
+		globalTearDowns = savedTearDowns
+	}()
+
+	registeredSuites = nil
+	globalSetUps = nil
+	globalTearDowns = nil
+
+	var calls []string
+	record := func(name string) func() {
+		return func() { calls = append(calls, name) }
+	}
+
+	RegisterGlobalSetUp(record("SetUp 1"))
+	RegisterGlobalSetUp(record("SetUp 2"))
+	RegisterGlobalTearDown(record("TearDown 1"))
+	RegisterGlobalTearDown(record("TearDown 2"))
+
+	CaptureOutput(func() { runTestsInternal(t) })
+
+	// Set-up functions run in registration order, and tear-down functions in
+	// reverse.
+	assertEqInt(t, 4, len(calls))
+	expectEqStr(t, "SetUp 1", calls[0])
+	expectEqStr(t, "SetUp 2", calls[1])
+	expectEqStr(t, "TearDown 2", calls[2])
+	expectEqStr(t, "TearDown 1", calls[3])
+}
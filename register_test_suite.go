@@ -42,6 +42,22 @@ type TearDownTestSuiteInterface interface {
 	TearDownTestSuite()
 }
 
+// Test suites that implement this interface have special meaning to
+// RegisterTestSuite. BeforeAll is an alternative name for SetUpTestSuite,
+// called in the same way. A suite may have both, in which case
+// SetUpTestSuite is called first.
+type BeforeAllInterface interface {
+	BeforeAll()
+}
+
+// Test suites that implement this interface have special meaning to
+// RegisterTestSuite. AfterAll is an alternative name for TearDownTestSuite,
+// called in the same way. A suite may have both, in which case AfterAll is
+// called first.
+type AfterAllInterface interface {
+	AfterAll()
+}
+
 // Test suites that implement this interface have special meaning to
 // Register.
 type SetUpInterface interface {
@@ -61,6 +77,39 @@ type TearDownInterface interface {
 	TearDown()
 }
 
+// Test suites that implement this interface have special meaning to
+// Register. BeforeEach is an alternative to SetUp, called in the same way but
+// without a TestInfo. A suite may have both, in which case BeforeEach is
+// called first.
+type BeforeEachInterface interface {
+	BeforeEach()
+}
+
+// Test suites that implement this interface have special meaning to
+// Register. AfterEach is an alternative name for TearDown, called in the same
+// way. A suite may have both, in which case TearDown is called first.
+type AfterEachInterface interface {
+	AfterEach()
+}
+
+// Test suites that implement this interface have special meaning to
+// RegisterTestSuite.
+type Annotatable interface {
+	// This method is called once, when the suite is registered, with a zero
+	// value of the test suite type as its receiver. The result is used as the
+	// Annotations field of the registered TestSuite.
+	Annotations() map[string]string
+}
+
+// Implemented by BaseSuite, whose subject is set after calling the suite's
+// SetUpSubject method.
+type subjectHolder interface {
+	subjectType() reflect.Type
+	setSubject(v reflect.Value)
+}
+
+var subjectHolderType = reflect.TypeOf((*subjectHolder)(nil)).Elem()
+
 // RegisterTestSuite tells ogletest about a test suite containing tests that it
 // should run. Any exported method on the type pointed to by the supplied
 // prototype value will be treated as test methods, with the exception of the
@@ -68,9 +117,14 @@ type TearDownInterface interface {
 // as described in the documentation for those interfaces:
 //
 //  *  SetUpTestSuiteInterface
+//  *  BeforeAllInterface
+//  *  BeforeEachInterface
 //  *  SetUpInterface
 //  *  TearDownInterface
+//  *  AfterEachInterface
+//  *  AfterAllInterface
 //  *  TearDownTestSuiteInterface
+//  *  Annotatable
 //
 // Each test method is invoked on a different receiver, which is initially a
 // zero value of the test suite type.
@@ -109,6 +163,12 @@ func RegisterTestSuite(p interface{}) {
 		panic("RegisterTestSuite called with nil suite.")
 	}
 
+	Register(makeTestSuite(p))
+}
+
+// Transform the supplied pointer to a test suite struct into a TestSuite, as
+// described in the documentation for RegisterTestSuite.
+func makeTestSuite(p interface{}) TestSuite {
 	val := reflect.ValueOf(p)
 	typ := val.Type()
 	var zeroInstance reflect.Value
@@ -117,44 +177,160 @@ func RegisterTestSuite(p interface{}) {
 	suite := TestSuite{}
 	suite.Name = typ.Elem().Name()
 
+	// Either or both names may be used for the suite-level set-up and tear-down
+	// methods, with the newer names innermost.
+	var setUps, tearDowns []func()
+
 	zeroInstance = reflect.New(typ.Elem())
 	if i, ok := zeroInstance.Interface().(SetUpTestSuiteInterface); ok {
-		suite.SetUp = func() { i.SetUpTestSuite() }
+		setUps = append(setUps, func() { i.SetUpTestSuite() })
+	}
+
+	zeroInstance = reflect.New(typ.Elem())
+	if i, ok := zeroInstance.Interface().(BeforeAllInterface); ok {
+		setUps = append(setUps, func() { i.BeforeAll() })
+	}
+
+	zeroInstance = reflect.New(typ.Elem())
+	if i, ok := zeroInstance.Interface().(AfterAllInterface); ok {
+		tearDowns = append(tearDowns, func() { i.AfterAll() })
 	}
 
 	zeroInstance = reflect.New(typ.Elem())
 	if i, ok := zeroInstance.Interface().(TearDownTestSuiteInterface); ok {
-		suite.TearDown = func() { i.TearDownTestSuite() }
+		tearDowns = append(tearDowns, func() { i.TearDownTestSuite() })
+	}
+
+	suite.SetUp = callInOrder(setUps)
+	suite.TearDown = callInOrder(tearDowns)
+
+	zeroInstance = reflect.New(typ.Elem())
+	if i, ok := zeroInstance.Interface().(Annotatable); ok {
+		suite.Annotations = i.Annotations()
 	}
 
+	// Suites embedding BaseSuite have extra methods that aren't tests.
+	hasSubject := typ.Implements(subjectHolderType)
+
 	// Transform a list of test methods for the suite, filtering them to just the
 	// ones that we don't need to skip.
 	for _, method := range filterMethods(suite.Name, srcutil.GetMethodsInSourceOrder(typ)) {
-		var tf TestFunction
-		tf.Name = method.Name
+		if hasSubject && isSubjectMethod(method.Name) {
+			continue
+		}
 
 		// Create an instance to be operated on by all of the TestFunction's
-		// internal functions.
+		// internal functions, and save the TestFunction.
 		instance := reflect.New(typ.Elem())
+		suite.TestFunctions = append(
+			suite.TestFunctions,
+			makeTestFunction(instance, method, hasSubject))
+	}
+
+	return suite
+}
 
-		// Bind the functions to the instance.
-		if i, ok := instance.Interface().(SetUpInterface); ok {
-			tf.SetUp = func(ti *TestInfo) { i.SetUp(ti) }
+// Return a function that calls each of the supplied functions in order, or
+// nil if there are none.
+func callInOrder(fs []func()) func() {
+	switch len(fs) {
+	case 0:
+		return nil
+
+	case 1:
+		return fs[0]
+	}
+
+	return func() {
+		for _, f := range fs {
+			f()
 		}
+	}
+}
+
+// Create a TestFunction that runs the supplied method on the supplied
+// instance of a test suite struct, along with its SetUp and TearDown methods.
+func makeTestFunction(
+	instance reflect.Value,
+	method reflect.Method,
+	hasSubject bool) (tf TestFunction) {
+	tf.Name = method.Name
+
+	// Bind the functions to the instance. Either or both names may be used for
+	// the per-test set-up and tear-down methods, with the older names
+	// innermost.
+	beforeEach, hasBeforeEach := instance.Interface().(BeforeEachInterface)
+	setUp, hasSetUp := instance.Interface().(SetUpInterface)
+	if hasBeforeEach || hasSetUp {
+		tf.SetUp = func(ti *TestInfo) {
+			if hasBeforeEach {
+				beforeEach.BeforeEach()
+			}
+
+			if hasSetUp {
+				setUp.SetUp(ti)
+			}
+		}
+	}
 
-		methodCopy := method
-		tf.Run = func() { runTestMethod(instance, methodCopy) }
+	tf.Run = func() { runTestMethod(instance, method) }
 
-		if i, ok := instance.Interface().(TearDownInterface); ok {
-			tf.TearDown = func() { i.TearDown() }
+	tearDown, hasTearDown := instance.Interface().(TearDownInterface)
+	afterEach, hasAfterEach := instance.Interface().(AfterEachInterface)
+	if hasTearDown || hasAfterEach {
+		tf.TearDown = func() {
+			if hasTearDown {
+				tearDown.TearDown()
+			}
+
+			if hasAfterEach {
+				afterEach.AfterEach()
+			}
 		}
+	}
 
-		// Save the TestFunction.
-		suite.TestFunctions = append(suite.TestFunctions, tf)
+	if hasSubject {
+		tf.SetUp = setUpSubjectFirst(instance, tf.SetUp)
 	}
 
-	// Register the suite.
-	Register(suite)
+	return
+}
+
+// Return a SetUp function for the supplied BaseSuite-embedding instance that
+// calls its SetUpSubject method and records the result as the subject before
+// calling the original SetUp function, if any.
+func setUpSubjectFirst(
+	instance reflect.Value,
+	setUp func(*TestInfo)) func(*TestInfo) {
+	method := instance.MethodByName("SetUpSubject")
+	if !method.IsValid() {
+		panic(fmt.Sprintf(
+			"%s embeds BaseSuite but has no SetUpSubject method.",
+			instance.Type().Elem().Name()))
+	}
+
+	if method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+		panic(fmt.Sprintf(
+			"%s: SetUpSubject must take no arguments and return the subject.",
+			instance.Type().Elem().Name()))
+	}
+
+	holder := instance.Interface().(subjectHolder)
+	if out := method.Type().Out(0); !out.AssignableTo(holder.subjectType()) {
+		panic(fmt.Sprintf(
+			"%s: SetUpSubject returns %v, which is not assignable to the "+
+				"BaseSuite subject type %v.",
+			instance.Type().Elem().Name(),
+			out,
+			holder.subjectType()))
+	}
+
+	return func(ti *TestInfo) {
+		holder.setSubject(method.Call(nil)[0])
+		if setUp != nil {
+			setUp(ti)
+		}
+	}
 }
 
 func runTestMethod(suite reflect.Value, method reflect.Method) {
@@ -184,8 +360,17 @@ func filterMethods(suiteName string, in []reflect.Method) (out []reflect.Method)
 func isSpecialMethod(name string) bool {
 	return (name == "SetUpTestSuite") ||
 		(name == "TearDownTestSuite") ||
+		(name == "BeforeAll") ||
+		(name == "AfterAll") ||
 		(name == "SetUp") ||
-		(name == "TearDown")
+		(name == "TearDown") ||
+		(name == "BeforeEach") ||
+		(name == "AfterEach") ||
+		(name == "Annotations")
+}
+
+func isSubjectMethod(name string) bool {
+	return (name == "SetUpSubject") || (name == "Subject")
 }
 
 func isExportedMethod(name string) bool {
@@ -0,0 +1,131 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"reflect"
+	"testing"
+)
+
+type annotatedSuite struct {
+}
+
+func (t *annotatedSuite) Annotations() map[string]string {
+	return map[string]string{"team": "backend", "owner": "taco"}
+}
+
+func (t *annotatedSuite) SomeTest() {
+}
+
+func TestAnnotations(t *testing.T) {
+	saved := registeredSuites
+	defer func() { registeredSuites = saved }()
+
+	RegisterTestSuite(&annotatedSuite{})
+	suite := registeredSuites[len(registeredSuites)-1]
+
+	assertEqInt(t, 1, len(suite.TestFunctions))
+	expectEqStr(
+		t,
+		" (owner=taco, team=backend)",
+		formatAnnotations(suite.Annotations))
+}
+
+var suiteHookCalls []string
+
+type beforeAllSuite struct {
+}
+
+func (t *beforeAllSuite) SetUpTestSuite() {
+	suiteHookCalls = append(suiteHookCalls, "SetUpTestSuite")
+}
+
+func (t *beforeAllSuite) BeforeAll() {
+	suiteHookCalls = append(suiteHookCalls, "BeforeAll")
+}
+
+func (t *beforeAllSuite) AfterAll() {
+	suiteHookCalls = append(suiteHookCalls, "AfterAll")
+}
+
+func (t *beforeAllSuite) TearDownTestSuite() {
+	suiteHookCalls = append(suiteHookCalls, "TearDownTestSuite")
+}
+
+func (t *beforeAllSuite) SomeTest() {
+}
+
+func TestBeforeAllAndAfterAll(t *testing.T) {
+	suiteHookCalls = nil
+	suite := makeTestSuite(&beforeAllSuite{})
+
+	// The hooks should not be treated as tests.
+	assertEqInt(t, 1, len(suite.TestFunctions))
+	expectEqStr(t, "SomeTest", suite.TestFunctions[0].Name)
+
+	// Both names should be honored, newer names innermost.
+	suite.SetUp()
+	suite.TearDown()
+
+	assertEqInt(t, 4, len(suiteHookCalls))
+	expectEqStr(t, "SetUpTestSuite", suiteHookCalls[0])
+	expectEqStr(t, "BeforeAll", suiteHookCalls[1])
+	expectEqStr(t, "AfterAll", suiteHookCalls[2])
+	expectEqStr(t, "TearDownTestSuite", suiteHookCalls[3])
+}
+
+type beforeEachSuite struct {
+	calls []string
+}
+
+func (t *beforeEachSuite) BeforeEach() {
+	t.calls = append(t.calls, "BeforeEach")
+}
+
+func (t *beforeEachSuite) SetUp(ti *TestInfo) {
+	t.calls = append(t.calls, "SetUp")
+}
+
+func (t *beforeEachSuite) TearDown() {
+	t.calls = append(t.calls, "TearDown")
+}
+
+func (t *beforeEachSuite) AfterEach() {
+	t.calls = append(t.calls, "AfterEach")
+}
+
+func (t *beforeEachSuite) SomeTest() {
+}
+
+func TestBeforeEachAndAfterEach(t *testing.T) {
+	instance := &beforeEachSuite{}
+	method, _ := reflect.TypeOf(instance).MethodByName("SomeTest")
+	tf := makeTestFunction(reflect.ValueOf(instance), method, false)
+
+	// The hooks should not be treated as tests.
+	suite := makeTestSuite(&beforeEachSuite{})
+	assertEqInt(t, 1, len(suite.TestFunctions))
+
+	// Both names should be honored, older names innermost.
+	tf.SetUp(nil)
+	tf.TearDown()
+
+	assertEqInt(t, 4, len(instance.calls))
+	expectEqStr(t, "BeforeEach", instance.calls[0])
+	expectEqStr(t, "SetUp", instance.calls[1])
+	expectEqStr(t, "TearDown", instance.calls[2])
+	expectEqStr(t, "AfterEach", instance.calls[3])
+}
@@ -0,0 +1,53 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import "github.com/jacobsa/oglematchers"
+
+// Requirement is a value about which assertions can be made using method
+// chaining. See TestInfo.Require.
+type Requirement struct {
+	x interface{}
+}
+
+// Require returns a Requirement for x, allowing assertions to be written as a
+// chain. Each method of the result is equivalent to AssertThat.
+//
+// For example:
+//
+//     CurrentTest().Require(len(users)).To(Equals(2))
+//     CurrentTest().Require(err).NotTo(Equals(nil), "while loading %s", path)
+//
+func (info *TestInfo) Require(x interface{}) *Requirement {
+	return &Requirement{x}
+}
+
+// To(m) is equivalent to AssertThat(x, m), where x is the value given to
+// Require.
+func (r *Requirement) To(m oglematchers.Matcher, errorParts ...interface{}) {
+	assertThat(r.x, m, 1, errorParts)
+}
+
+// NotTo(m) is equivalent to AssertThat(x, oglematchers.Not(m)), where x is
+// the value given to Require.
+func (r *Requirement) NotTo(m oglematchers.Matcher, errorParts ...interface{}) {
+	assertThat(r.x, oglematchers.Not(m), 1, errorParts)
+}
+
+// ToNot is a synonym for NotTo.
+func (r *Requirement) ToNot(m oglematchers.Matcher, errorParts ...interface{}) {
+	assertThat(r.x, oglematchers.Not(m), 1, errorParts)
+}
@@ -0,0 +1,59 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"testing"
+
+	. "github.com/jacobsa/oglematchers"
+)
+
+func TestRequireToPasses(t *testing.T) {
+	setUpCurrentTest()
+	CurrentTest().Require(17).To(Equals(17))
+	CurrentTest().Require(17).NotTo(Equals(19))
+
+	expectEqInt(t, 0, len(currentlyRunningTest.failureRecords))
+}
+
+func TestRequireToAborts(t *testing.T) {
+	setUpCurrentTest()
+
+	aborted := false
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				if !isAbortError(r) {
+					panic(r)
+				}
+
+				aborted = true
+			}
+		}()
+
+		CurrentTest().Require(17).To(Equals(19), "taco %d", 1)
+	}()
+
+	if !aborted {
+		t.Errorf("Expected the test to be aborted.")
+	}
+
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+	record := currentlyRunningTest.failureRecords[0]
+
+	expectEqStr(t, "require_test.go", record.FileName)
+	expectEqStr(t, "Expected: 19\nActual:   17\ntaco 1", record.Error)
+}
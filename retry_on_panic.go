@@ -0,0 +1,42 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+// RetryOnPanic calls fn, calling it again up to n more times for as long as
+// it keeps panicking. It returns the values of the panics that occurred, in
+// order, once an attempt returns normally. If all n+1 attempts panic, the
+// value of the last panic is re-panicked. A failed AssertThat within fn is not
+// retried, and halts the test as usual.
+//
+// For example:
+//
+//     panics := RetryOnPanic(3, func() { pool.Drain() })
+//     ExpectThat(len(panics), LessThan(3))
+//
+func RetryOnPanic(n int, fn func()) (panics []interface{}) {
+	for attempt := 0; ; attempt++ {
+		panicked, value := InterceptPanics(fn)
+		if !panicked {
+			return
+		}
+
+		if attempt == n {
+			panic(value)
+		}
+
+		panics = append(panics, value)
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import "testing"
+
+func TestRetryOnPanicEventuallySucceeds(t *testing.T) {
+	calls := 0
+	panics := RetryOnPanic(3, func() {
+		calls++
+		if calls < 3 {
+			panic(calls)
+		}
+	})
+
+	expectEqInt(t, 3, calls)
+	assertEqInt(t, 2, len(panics))
+	expectEqInt(t, 1, panics[0].(int))
+	expectEqInt(t, 2, panics[1].(int))
+}
+
+func TestRetryOnPanicGivesUp(t *testing.T) {
+	calls := 0
+	var r interface{}
+	func() {
+		defer func() { r = recover() }()
+		RetryOnPanic(2, func() {
+			calls++
+			panic(calls)
+		})
+	}()
+
+	expectEqInt(t, 3, calls)
+	if r != 3 {
+		t.Errorf("Expected the last panic to be re-panicked, got %v", r)
+	}
+}
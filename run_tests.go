@@ -23,12 +23,15 @@ import (
 	"path"
 	"regexp"
 	"runtime"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/jacobsa/reqtrace"
+	"golang.org/x/net/context"
 )
 
 var fTestFilter = flag.String(
@@ -41,6 +44,99 @@ var fStopEarly = flag.Bool(
 	false,
 	"If true, stop after the first failure.")
 
+var fFocus = flag.Bool(
+	"ogletest.focus",
+	true,
+	"If true and any test's name begins with Focus, run only such tests.")
+
+var fFailOnPending = flag.Bool(
+	"ogletest.fail-on-pending",
+	false,
+	"If true, treat pending tests (named like PendingFoo) as failures.")
+
+var fShowMeasures = flag.Bool(
+	"ogletest.show-measures",
+	false,
+	"If true, print the timings recorded by calls to Measure.")
+
+var fRerunFails = flag.Int(
+	"ogletest.rerun-fails",
+	0,
+	"Rerun failing tests up to this many times, failing only if every run fails.")
+
+var fNoDedup = flag.Bool(
+	"ogletest.no-dedup",
+	false,
+	"If true, report every failure even if identical to an earlier one.")
+
+var fStrictStructs = flag.Bool(
+	"ogletest.strict-structs",
+	false,
+	"If true, MatchStruct fails for exported fields not mentioned in its map.")
+
+var fTrace = flag.Bool(
+	"ogletest.trace",
+	false,
+	"If true, print timestamped lifecycle events for suites and tests to stderr.")
+
+var fPanicOnFailure = flag.Bool(
+	"ogletest.panic-on-failure",
+	false,
+	"If true, crash with a stack trace at the first failure. Useful with a debugger.")
+
+var fUpdateGolden = flag.Bool(
+	"ogletest.update-golden",
+	false,
+	"If true, overwrite golden files with actual output instead of comparing.")
+
+var fUpdateSnapshots = flag.Bool(
+	"ogletest.update-snapshots",
+	false,
+	"If true, overwrite snapshots with actual values instead of comparing.")
+
+var fColor = newAutoBoolFlag(
+	"ogletest.color",
+	"If set, whether to colorize output. By default output is colorized iff "+
+		"stdout is a terminal.")
+
+var fDots = flag.Bool(
+	"ogletest.dots",
+	false,
+	"If true, print a character per test instead of its name, then a summary.")
+
+var fSummaryOnly = flag.Bool(
+	"ogletest.summary-only",
+	false,
+	"If true, print nothing per test; print failures and counts at the end.")
+
+var fMaxFailures = flag.Int(
+	"ogletest.max-failures",
+	0,
+	"If positive, stop running tests after this many have failed.")
+
+var fShort = flag.Bool(
+	"ogletest.short",
+	false,
+	"If true, tell long-running tests to shorten their run time.")
+
+// IsShort reports whether the --ogletest.short flag, or go test's -short flag,
+// was set. Slow tests may use this to skip themselves or to do less work.
+func IsShort() bool {
+	if *fShort {
+		return true
+	}
+
+	// Unlike testing.Short, this doesn't panic when called before the testing
+	// package has registered its flags.
+	f := flag.Lookup("test.short")
+	return f != nil && f.Value.String() == "true"
+}
+
+var fTimeout = flag.Duration(
+	"ogletest.timeout",
+	0,
+	"If non-zero, cancel each test's context after this long.")
+
 // runTestsOnce protects RunTests from executing multiple times.
 var runTestsOnce sync.Once
 
@@ -49,8 +145,45 @@ func isAbortError(x interface{}) bool {
 	return ok
 }
 
-// Run a single test function, returning a slice of failure records.
-func runTestFunction(tf TestFunction) (failures []FailureRecord) {
+func isFailurePanic(x interface{}) bool {
+	_, ok := x.(failurePanic)
+	return ok
+}
+
+// Panic if the user has supplied an incompatible combination of flags.
+func checkFlags() {
+	if *fPanicOnFailure && *fStopEarly {
+		panic(
+			"--ogletest.panic-on-failure and --ogletest.stop_early are " +
+				"mutually exclusive.")
+	}
+
+	if *fMaxFailures < 0 {
+		panic(fmt.Sprintf(
+			"Invalid value for --ogletest.max-failures: %d",
+			*fMaxFailures))
+	}
+}
+
+// Return true iff the user asked via --ogletest.max-failures to stop after a
+// number of failed tests and that many have failed, in which case the caller
+// should run no further tests. The cutoff is noted for printSummary.
+func stopForMaxFailures() bool {
+	if *fMaxFailures == 0 || gStats.counts[ResultFailed] < *fMaxFailures {
+		return false
+	}
+
+	gStats.hitMaxFailures = true
+	return true
+}
+
+// Run a single test function, returning a slice of failure records. If timeout
+// is positive, the test's context is cancelled after that long.
+func runTestFunction(
+	t *testing.T,
+	fullName string,
+	tf TestFunction,
+	timeout time.Duration) (failures []FailureRecord) {
 	// Set up a clean slate for this test. Make sure to reset it after everything
 	// below is finished, so we don't accidentally use it elsewhere.
 	currentlyRunningTest = newTestInfo()
@@ -59,32 +192,58 @@ func runTestFunction(tf TestFunction) (failures []FailureRecord) {
 	}()
 
 	ti := currentlyRunningTest
+	ti.t = t
 
 	// Start a trace.
 	var reportOutcome reqtrace.ReportFunc
 	ti.Ctx, reportOutcome = reqtrace.Trace(ti.Ctx, tf.Name)
 
+	// Arrange for the test's context to be cancelled when the test finishes, or
+	// earlier if the user has asked for a timeout.
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ti.Ctx, cancel = context.WithTimeout(ti.Ctx, timeout)
+	} else {
+		ti.Ctx, cancel = context.WithCancel(ti.Ctx)
+	}
+
+	defer cancel()
+
 	// Run the SetUp function, if any, paying attention to whether it panics.
 	setUpPanicked := false
 	if tf.SetUp != nil {
+		trace("SetUp %s", fullName)
 		setUpPanicked = runWithProtection(func() { tf.SetUp(ti) })
 	}
 
 	// Run the test function itself, but only if the SetUp function didn't panic.
 	// (This includes AssertThat errors.)
 	if !setUpPanicked {
+		trace("Start %s", fullName)
 		runWithProtection(tf.Run)
+		trace("End %s", fullName)
 	}
 
 	// Run the TearDown function, if any.
 	if tf.TearDown != nil {
+		trace("TearDown %s", fullName)
 		runWithProtection(tf.TearDown)
 	}
 
+	// Run any cleanup functions registered by the test.
+	ti.runCleanups()
+
 	// Tell the mock controller for the tests to report any errors it's sitting
 	// on.
 	ti.MockController.Finish()
 
+	// Print any measurements the test made, if the user wants to see them.
+	if *fShowMeasures {
+		for _, m := range ti.measurements {
+			fmt.Println(m)
+		}
+	}
+
 	// Report the outcome to reqtrace.
 	if len(ti.failureRecords) == 0 {
 		reportOutcome(nil)
@@ -130,6 +289,23 @@ func StopRunningTests() {
 // runTestsInternal does the real work of RunTests, which simply wraps it in a
 // sync.Once.
 func runTestsInternal(t *testing.T) {
+	checkFlags()
+	filter := flagTestFilter()
+	resetStats()
+
+	// If any tests have been focused, we will run only those.
+	focused := *fFocus && anyFocusedTests()
+	if focused {
+		printBanner(
+			"%s Skipping non-focused tests: focused tests are present\n",
+			Colorize("[----------]", colorGreen))
+	}
+
+	// Run the global set-up functions, if any.
+	for _, f := range globalSetUps {
+		f()
+	}
+
 	// Process each registered suite.
 	for _, suite := range registeredSuites {
 		// Stop now if we've already seen a failure and we've been told to stop
@@ -138,80 +314,271 @@ func runTestsInternal(t *testing.T) {
 			break
 		}
 
-		// Print a banner.
-		fmt.Printf("[----------] Running tests from %s\n", suite.Name)
+		// Likewise if we've seen as many failures as we've been told to
+		// tolerate.
+		if stopForMaxFailures() {
+			break
+		}
+
+		// Run the suite, exiting if we were told to do so.
+		if runSuite(t, suite, focused, filter, *fTimeout) {
+			printSummary()
+			runGlobalTearDowns()
+			fmt.Println("Exiting early due to user request.")
+			os.Exit(1)
+		}
+	}
+
+	printSummary()
+	runGlobalTearDowns()
+}
+
+// Run the supplied suite, reporting failures to t. If focused is true, run
+// only the focused test functions. Run only those test functions whose full
+// names match filter, or if filter is nil run every test function in the
+// suite, ignoring Pending prefixes too. Cancel each test's context after
+// timeout, if positive. Return true iff the user asked us to stop running
+// tests via StopRunningTests, in which case the caller should exit after
+// cleaning up.
+func runSuite(
+	t *testing.T,
+	suite TestSuite,
+	focused bool,
+	filter *regexp.Regexp,
+	timeout time.Duration) (stoppedEarly bool) {
+	// Print a banner.
+	printBanner(
+		"%s Running tests from %s%s\n",
+		Colorize("[----------]", colorGreen),
+		suite.Name,
+		formatAnnotations(suite.Annotations))
+
+	// Run the SetUp function, if any.
+	if suite.SetUp != nil {
+		trace("SetUpTestSuite %s", suite.Name)
+		suite.SetUp()
+	}
 
-		// Run the SetUp function, if any.
-		if suite.SetUp != nil {
-			suite.SetUp()
+	// Run each test function that the user has not told us to skip.
+	testFunctions := suite.TestFunctions
+	var unfocused []TestFunction
+	if filter != nil {
+		testFunctions, unfocused = filterTestFunctions(suite, focused, filter)
+	}
+
+	for _, tf := range unfocused {
+		noteResult(suite.Name+"."+tf.Name, ResultSkipped, nil)
+	}
+
+	for _, tf := range testFunctions {
+		// Did the user request that we stop running tests? If so, skip the rest
+		// of this suite (and exit after tearing it down).
+		if atomic.LoadUint64(&gStopRunning) != 0 {
+			stoppedEarly = true
+			break
 		}
 
-		// Run each test function that the user has not told us to skip.
-		stoppedEarly := false
-		for _, tf := range filterTestFunctions(suite) {
-			// Did the user request that we stop running tests? If so, skip the rest
-			// of this suite (and exit after tearing it down).
-			if atomic.LoadUint64(&gStopRunning) != 0 {
-				stoppedEarly = true
-				break
+		// Have we seen as many failures as the user will tolerate? If so, skip
+		// the rest of this suite.
+		if stopForMaxFailures() {
+			break
+		}
+
+		// Pending tests are reported but not run.
+		if filter != nil && isPending(tf.Name) {
+			printBanner(
+				"%s %s.%s\n",
+				Colorize("[ PENDING  ]", colorYellow),
+				suite.Name,
+				tf.Name)
+
+			noteResult(suite.Name+"."+tf.Name, ResultPending, nil)
+			if *fFailOnPending {
+				t.Fail()
 			}
 
-			// Print a banner for the start of this test function.
-			fmt.Printf("[ RUN      ] %s.%s\n", suite.Name, tf.Name)
+			continue
+		}
+
+		// Print a banner for the start of this test function.
+		printBanner(
+			"%s %s.%s\n",
+			Colorize("[ RUN      ]", colorGreen),
+			suite.Name,
+			tf.Name)
+
+		// Run the test function. If it fails and the user has asked for
+		// reruns, try again until it passes or we run out of attempts, printing
+		// the failures from all but the last attempt as we go.
+		var failures []FailureRecord
+		var runDuration time.Duration
+		attempts := 0
+		failedAttempts := 0
+		totalAttempts := 1 + *fRerunFails
+		for attempt := 1; attempt <= totalAttempts; attempt++ {
+			attempts = attempt
+			if attempt > 1 {
+				printBanner(
+					"%s %s.%s (attempt %d/%d)\n",
+					Colorize("[ RETRY    ]", colorYellow),
+					suite.Name,
+					tf.Name,
+					attempt,
+					totalAttempts)
+			}
 
-			// Run the test function.
 			startTime := time.Now()
-			failures := runTestFunction(tf)
-			runDuration := time.Since(startTime)
+			failures = runTestFunction(t, suite.Name+"."+tf.Name, tf, timeout)
+			runDuration = time.Since(startTime)
 
-			// Print any failures, and mark the test as having failed if there are any.
-			for _, record := range failures {
-				t.Fail()
-				fmt.Printf(
-					"%s:%d:\n%s\n\n",
-					record.FileName,
-					record.LineNumber,
-					record.Error)
+			if len(failures) == 0 {
+				break
 			}
 
-			// Print a banner for the end of the test.
-			bannerMessage := "[       OK ]"
-			if len(failures) != 0 {
-				bannerMessage = "[  FAILED  ]"
+			failedAttempts++
+			if attempt < totalAttempts && !quietOutput() {
+				printFailures(failures)
 			}
+		}
 
-			// Print a summary of the time taken, if long enough.
-			var timeMessage string
-			if runDuration >= 25*time.Millisecond {
-				timeMessage = fmt.Sprintf(" (%s)", runDuration.String())
+		// Print any failures, and mark the test as having failed if there are any.
+		// If per-test output is suppressed, the failures are printed at the end.
+		result := ResultPassed
+		if len(failures) != 0 {
+			t.Fail()
+			result = ResultFailed
+			if !quietOutput() {
+				printFailures(failures)
 			}
+		}
 
-			fmt.Printf(
-				"%s %s.%s%s\n",
-				bannerMessage,
-				suite.Name,
-				tf.Name,
-				timeMessage)
+		noteResult(suite.Name+"."+tf.Name, result, failures)
 
-			// Stop running tests from this suite if we've been told to stop early
-			// and this test failed.
-			if t.Failed() && *fStopEarly {
-				break
+		// Print a banner for the end of the test.
+		bannerMessage := Colorize("[       OK ]", colorGreen)
+		if len(failures) != 0 {
+			bannerMessage = Colorize("[  FAILED  ]", colorRed)
+		}
+
+		// Note the outcome of any reruns, calling out tests that passed only
+		// after failing.
+		var rerunMessage string
+		if failedAttempts > 0 && *fRerunFails > 0 {
+			label := "failed"
+			if len(failures) == 0 {
+				label = "FLAKY: failed"
 			}
+
+			rerunMessage = fmt.Sprintf(
+				" (%s %d/%d)",
+				label,
+				failedAttempts,
+				attempts)
 		}
 
-		// Run the suite's TearDown function, if any.
-		if suite.TearDown != nil {
-			suite.TearDown()
+		// Print a summary of the time taken, if long enough.
+		var timeMessage string
+		if runDuration >= 25*time.Millisecond {
+			timeMessage = fmt.Sprintf(" (%s)", runDuration.String())
 		}
 
-		// Were we told to exit early?
-		if stoppedEarly {
-			fmt.Println("Exiting early due to user request.")
-			os.Exit(1)
+		printBanner(
+			"%s %s.%s%s%s\n",
+			bannerMessage,
+			suite.Name,
+			tf.Name,
+			rerunMessage,
+			timeMessage)
+
+		// Stop running tests from this suite if we've been told to stop early
+		// and this test failed.
+		if t.Failed() && *fStopEarly {
+			break
+		}
+	}
+
+	// Run the suite's TearDown function, if any.
+	if suite.TearDown != nil {
+		trace("TearDownTestSuite %s", suite.Name)
+		suite.TearDown()
+	}
+
+	if !stoppedEarly {
+		printBanner(
+			"%s Finished with tests from %s\n",
+			Colorize("[----------]", colorGreen),
+			suite.Name)
+	}
+
+	return
+}
+
+// Run the global tear-down functions, most recently registered first.
+func runGlobalTearDowns() {
+	for i := len(globalTearDowns) - 1; i >= 0; i-- {
+		globalTearDowns[i]()
+	}
+}
+
+// Format the supplied suite annotations for display after the suite's name,
+// sorted by key. Return the empty string if there are none.
+func formatAnnotations(annotations map[string]string) string {
+	if len(annotations) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(" (%s)", formatKeyValues(annotations))
+}
+
+// Format the supplied map as a list of key=value pairs, sorted by key.
+func formatKeyValues(m map[string]string) string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	var parts []string
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s=%s", k, m[k]))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// If the user has asked for tracing with --ogletest.trace, print a timestamped
+// line describing a lifecycle event to stderr.
+func trace(format string, v ...interface{}) {
+	if !*fTrace {
+		return
+	}
+
+	fmt.Fprintf(
+		os.Stderr,
+		"[ TRACE    ] %s %s\n",
+		time.Now().Format("15:04:05.000000"),
+		fmt.Sprintf(format, v...))
+}
+
+// Print the supplied failure records for a test.
+func printFailures(failures []FailureRecord) {
+	for _, record := range failures {
+		fmt.Printf(
+			"%s:%d:\n%s\n",
+			record.FileName,
+			record.LineNumber,
+			Colorize(record.Error, colorRed))
+
+		if len(record.Tags) > 0 {
+			fmt.Printf("(Tags: %s)\n", formatKeyValues(record.Tags))
+		}
+
+		if record.repeats > 0 {
+			fmt.Printf("(Repeated %d more times.)\n", record.repeats)
 		}
 
-		fmt.Printf("[----------] Finished with tests from %s\n", suite.Name)
+		fmt.Println()
 	}
 }
 
@@ -277,6 +644,12 @@ func runWithProtection(f func()) (panicked bool) {
 
 		panicked = true
 
+		// Failures that the user has asked to crash the program must not be
+		// swallowed.
+		if isFailurePanic(r) {
+			panic(r)
+		}
+
 		// We modify the currently running test below.
 		currentlyRunningTest.mu.Lock()
 		defer currentlyRunningTest.mu.Unlock()
@@ -289,9 +662,7 @@ func runWithProtection(f func()) (panicked bool) {
 			panicRecord.Error = fmt.Sprintf(
 				"panic: %v\n\n%s", r, formatPanicStack())
 
-			currentlyRunningTest.failureRecords = append(
-				currentlyRunningTest.failureRecords,
-				panicRecord)
+			currentlyRunningTest.addFailureRecord(panicRecord)
 		}
 	}()
 
@@ -334,16 +705,72 @@ func formatPanicStack() string {
 	return buf.String()
 }
 
-// Filter test functions according to the user-supplied filter flag.
-func filterTestFunctions(suite TestSuite) (out []TestFunction) {
+// Return true iff name consists of the supplied prefix followed by nothing or
+// by another capitalized word, as in "FocusFoo" but not "Focuses".
+func hasWordPrefix(name string, prefix string) bool {
+	rest := strings.TrimPrefix(name, prefix)
+	return len(rest) < len(name) && (rest == "" || isExportedMethod(rest))
+}
+
+// Return true iff the test function with the supplied name has been focused,
+// by giving it a name like FocusFoo.
+func isFocused(name string) bool {
+	return hasWordPrefix(name, "Focus")
+}
+
+// Return true iff the test function with the supplied name is a placeholder
+// for a test not yet written, with a name like PendingFoo.
+func isPending(name string) bool {
+	return hasWordPrefix(name, "Pending")
+}
+
+// Return true iff any registered test function has been focused.
+func anyFocusedTests() bool {
+	for _, suite := range registeredSuites {
+		if hasFocusedTests(suite) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Return true iff any of the suite's test functions has been focused.
+func hasFocusedTests(suite TestSuite) bool {
+	for _, tf := range suite.TestFunctions {
+		if isFocused(tf.Name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Compile the filter supplied with --ogletest.run, panicking if it's invalid.
+func flagTestFilter() *regexp.Regexp {
 	re, err := regexp.Compile(*fTestFilter)
 	if err != nil {
 		panic("Invalid value for --ogletest.run: " + err.Error())
 	}
 
+	return re
+}
+
+// Filter test functions to those whose full names match filter. If focused is
+// true, also filter out those that have not been focused, returning them
+// separately.
+func filterTestFunctions(
+	suite TestSuite,
+	focused bool,
+	filter *regexp.Regexp) (out []TestFunction, unfocused []TestFunction) {
 	for _, tf := range suite.TestFunctions {
 		fullName := fmt.Sprintf("%s.%s", suite.Name, tf.Name)
-		if !re.MatchString(fullName) {
+		if !filter.MatchString(fullName) {
+			continue
+		}
+
+		if focused && !isFocused(tf.Name) {
+			unfocused = append(unfocused, tf)
 			continue
 		}
 
@@ -0,0 +1,86 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"flag"
+	"regexp"
+	"testing"
+)
+
+func TestTrace(t *testing.T) {
+	// Nothing is printed unless the flag is set.
+	_, stderr := CaptureOutput(func() { trace("SetUp %s", "FooTest.Bar") })
+	expectEqStr(t, "", stderr)
+
+	*fTrace = true
+	defer func() { *fTrace = false }()
+
+	_, stderr = CaptureOutput(func() { trace("SetUp %s", "FooTest.Bar") })
+
+	re := regexp.MustCompile(`^\[ TRACE    \] \d\d:\d\d:\d\d\.\d{6} SetUp FooTest.Bar\n$`)
+	if !re.MatchString(stderr) {
+		t.Errorf("Unexpected output: %q", stderr)
+	}
+}
+
+func TestFilterTestFunctions(t *testing.T) {
+	suite := TestSuite{
+		Name: "FooTest",
+		TestFunctions: []TestFunction{
+			{Name: "DoesBar"},
+			{Name: "FocusDoesBaz"},
+			{Name: "DoesQux"},
+		},
+	}
+
+	// The supplied filter is used rather than --ogletest.run.
+	out, unfocused := filterTestFunctions(
+		suite,
+		true,
+		regexp.MustCompile(`FooTest\..*Ba`))
+
+	assertEqInt(t, 1, len(out))
+	expectEqStr(t, "FocusDoesBaz", out[0].Name)
+
+	assertEqInt(t, 1, len(unfocused))
+	expectEqStr(t, "DoesBar", unfocused[0].Name)
+}
+
+func TestIsShort(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Run without -short to check IsShort.")
+	}
+
+	if IsShort() {
+		t.Errorf("Expected IsShort to be false.")
+	}
+
+	*fShort = true
+	if !IsShort() {
+		t.Errorf("Expected IsShort to be true with --ogletest.short.")
+	}
+
+	*fShort = false
+
+	// go test's own -short flag is honored too.
+	flag.Set("test.short", "true")
+	defer flag.Set("test.short", "false")
+
+	if !testing.Short() || !IsShort() {
+		t.Errorf("Expected IsShort to be true with -test.short.")
+	}
+}
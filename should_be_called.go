@@ -0,0 +1,77 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"runtime"
+
+	"github.com/jacobsa/oglemock"
+)
+
+// ShouldBeCalledOnce expresses an expectation, on the supplied controller,
+// that the named method of o will be called exactly once with arguments
+// matching args. The expectation is attributed to the caller's file and line.
+// The result may be used to specify the call's action.
+//
+// For example:
+//
+//     ShouldBeCalledOnce(t.controller, t.writer, "Write", Any()).
+//       WillOnce(oglemock.Return(1, nil))
+//
+func ShouldBeCalledOnce(
+	ctrl oglemock.Controller,
+	o oglemock.MockObject,
+	method string,
+	args ...interface{}) oglemock.Expectation {
+	return expectCallTimes(ctrl, 1, o, method, args)
+}
+
+// ShouldBeCalledTimes is like ShouldBeCalledOnce, but expects exactly n
+// calls.
+func ShouldBeCalledTimes(
+	ctrl oglemock.Controller,
+	n uint,
+	o oglemock.MockObject,
+	method string,
+	args ...interface{}) oglemock.Expectation {
+	return expectCallTimes(ctrl, n, o, method, args)
+}
+
+// ShouldNotBeCalled is like ShouldBeCalledOnce, but expects that no matching
+// call will be made.
+func ShouldNotBeCalled(
+	ctrl oglemock.Controller,
+	o oglemock.MockObject,
+	method string,
+	args ...interface{}) oglemock.Expectation {
+	return expectCallTimes(ctrl, 0, o, method, args)
+}
+
+// Register an expectation for n calls, attributed to the frame two above
+// this one (the user's frame when called by the exported helpers above).
+func expectCallTimes(
+	ctrl oglemock.Controller,
+	n uint,
+	o oglemock.MockObject,
+	method string,
+	args []interface{}) oglemock.Expectation {
+	_, file, lineNumber, ok := runtime.Caller(2)
+	if !ok {
+		panic("expectCallTimes: runtime.Caller")
+	}
+
+	return ctrl.ExpectCall(o, method, file, lineNumber)(args...).Times(n)
+}
@@ -0,0 +1,73 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"path"
+	"testing"
+
+	"github.com/jacobsa/oglemock"
+)
+
+type fakeExpectation struct {
+	oglemock.Expectation
+	args  []interface{}
+	times uint
+}
+
+func (e *fakeExpectation) Times(n uint) oglemock.Expectation {
+	e.times = n
+	return e
+}
+
+type expectingController struct {
+	oglemock.Controller
+	method      string
+	fileName    string
+	expectation *fakeExpectation
+}
+
+func (c *expectingController) ExpectCall(
+	o oglemock.MockObject,
+	methodName string,
+	fileName string,
+	lineNumber int) oglemock.PartialExpecation {
+	c.method = methodName
+	c.fileName = path.Base(fileName)
+	return func(args ...interface{}) oglemock.Expectation {
+		c.expectation = &fakeExpectation{args: args}
+		return c.expectation
+	}
+}
+
+func TestShouldBeCalledHelpers(t *testing.T) {
+	c := &expectingController{}
+	o := &fakeMockObject{1}
+
+	ShouldBeCalledTimes(c, 3, o, "Foo", 17, "taco")
+	expectEqStr(t, "Foo", c.method)
+	expectEqStr(t, "should_be_called_test.go", c.fileName)
+	expectEqInt(t, 3, int(c.expectation.times))
+	expectEqInt(t, 2, len(c.expectation.args))
+
+	ShouldBeCalledOnce(c, o, "Bar")
+	expectEqStr(t, "Bar", c.method)
+	expectEqInt(t, 1, int(c.expectation.times))
+
+	ShouldNotBeCalled(c, o, "Baz")
+	expectEqStr(t, "Baz", c.method)
+	expectEqInt(t, 0, int(c.expectation.times))
+}
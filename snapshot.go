@@ -0,0 +1,96 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// SnapshotAssert serializes actual to JSON and compares the result against the
+// snapshot stored in testdata/<name>.snap.json, alongside the calling test's
+// source file. If the snapshot doesn't yet exist, or the
+// --ogletest.update-snapshots flag is set, the snapshot is written instead.
+// On a mismatch, a failure record containing a diff is added and the test is
+// halted.
+//
+// The serialization is deterministic: map keys are sorted, as with
+// encoding/json.
+//
+// For example:
+//
+//     SnapshotAssert("parsed_config", config)
+//
+func SnapshotAssert(name string, actual interface{}) {
+	if currentlyRunningTest == nil {
+		panic("SnapshotAssert: no test info.")
+	}
+
+	if !checkSnapshot(testDataPath(1, name+".snap.json"), actual, 1) {
+		AbortTest()
+	}
+}
+
+// The generalized form of SnapshotAssert, comparing actual against the
+// snapshot at the supplied path. depth is the distance on the stack between
+// the caller's frame and the user's frame. Returns passed iff the snapshot
+// matched or was written.
+func checkSnapshot(path string, actual interface{}, depth int) (passed bool) {
+	serialized, err := json.MarshalIndent(actual, "", "  ")
+	if err != nil {
+		recordFailure(depth+1, fmt.Sprintf("SnapshotAssert: %v", err), nil)
+		return
+	}
+
+	serialized = append(serialized, '\n')
+
+	// Write the snapshot if it doesn't exist or the user asked us to.
+	expected, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) || *fUpdateSnapshots {
+		err = os.MkdirAll(filepath.Dir(path), 0755)
+		if err == nil {
+			err = ioutil.WriteFile(path, serialized, 0644)
+		}
+
+		if err != nil {
+			recordFailure(depth+1, fmt.Sprintf("SnapshotAssert: %v", err), nil)
+			return
+		}
+
+		passed = true
+		return
+	}
+
+	if err != nil {
+		recordFailure(depth+1, fmt.Sprintf("SnapshotAssert: %v", err), nil)
+		return
+	}
+
+	if d := Diff(string(expected), string(serialized)); d != "" {
+		recordFailure(
+			depth+1,
+			fmt.Sprintf("Value differs from snapshot %s:\n%s", path, d),
+			nil)
+
+		return
+	}
+
+	passed = true
+	return
+}
@@ -0,0 +1,74 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCheckSnapshot(t *testing.T) {
+	setUpCurrentTest()
+
+	dir, err := ioutil.TempDir("", "snapshot_test")
+	if err != nil {
+		t.Fatalf("TempDir: %v", err)
+	}
+
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "testdata", "foo.snap.json")
+
+	// The first run writes the snapshot, with sorted keys.
+	if !checkSnapshot(path, map[string]int{"b": 2, "a": 1}, 0) {
+		t.Errorf("Expected success.")
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+
+	expectEqStr(t, "{\n  \"a\": 1,\n  \"b\": 2\n}\n", string(contents))
+
+	// An identical value matches.
+	if !checkSnapshot(path, map[string]int{"a": 1, "b": 2}, 0) {
+		t.Errorf("Expected success.")
+	}
+
+	// A different one doesn't.
+	if checkSnapshot(path, map[string]int{"a": 1, "b": 3}, 0) {
+		t.Errorf("Expected failure.")
+	}
+
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+	record := currentlyRunningTest.failureRecords[0]
+
+	expectEqStr(t, "snapshot_test.go", record.FileName)
+	if !strings.HasSuffix(record.Error, "-  \"b\": 2\n+  \"b\": 3\n }\n") {
+		t.Errorf("Unexpected message: %s", record.Error)
+	}
+
+	// Unless we've been told to update it.
+	*fUpdateSnapshots = true
+	defer func() { *fUpdateSnapshots = false }()
+
+	if !checkSnapshot(path, map[string]int{"a": 1, "b": 3}, 0) {
+		t.Errorf("Expected success.")
+	}
+}
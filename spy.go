@@ -0,0 +1,124 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// A Spy records calls to function values that it has wrapped, for use when
+// the code under test accepts a dependency as a func rather than an interface
+// that could be mocked. Create one with NewSpy. A Spy is safe for concurrent
+// use.
+//
+// For example:
+//
+//     spy := NewSpy()
+//     clock := spy.Wrap(time.Now).(func() time.Time)
+//     s := NewScheduler(clock)
+//
+//     s.Tick()
+//     ExpectEq(1, spy.CallCount())
+//
+type Spy struct {
+	mu sync.Mutex
+
+	// The calls made so far, in order.
+	//
+	// GUARDED_BY(mu)
+	calls []spyCall
+}
+
+type spyCall struct {
+	args []interface{}
+	time time.Time
+}
+
+// NewSpy creates a spy that has not yet recorded any calls.
+func NewSpy() *Spy {
+	return &Spy{}
+}
+
+// Wrap returns a function with the same type as f, which must be a non-nil
+// function, that records each call with the spy before forwarding it to f.
+// The caller will generally want to type-assert the result back to the type
+// of f. For variadic functions, the variadic arguments are recorded as a
+// single slice.
+func (s *Spy) Wrap(f interface{}) interface{} {
+	v := reflect.ValueOf(f)
+	if v.Kind() != reflect.Func || v.IsNil() {
+		panic(fmt.Sprintf("Wrap: expected a non-nil function, got %T", f))
+	}
+
+	wrapped := reflect.MakeFunc(v.Type(), func(in []reflect.Value) []reflect.Value {
+		s.record(in)
+
+		if v.Type().IsVariadic() {
+			return v.CallSlice(in)
+		}
+
+		return v.Call(in)
+	})
+
+	return wrapped.Interface()
+}
+
+func (s *Spy) record(in []reflect.Value) {
+	c := spyCall{time: time.Now()}
+	for _, a := range in {
+		c.args = append(c.args, a.Interface())
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls = append(s.calls, c)
+}
+
+// CallCount returns the number of calls recorded so far, across all functions
+// wrapped by the spy.
+func (s *Spy) CallCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.calls)
+}
+
+// CallArgs returns the arguments of the nth recorded call, counting from
+// zero. It panics if fewer than n+1 calls have been recorded.
+func (s *Spy) CallArgs(n int) []interface{} {
+	return s.call(n, "CallArgs").args
+}
+
+// CallTime returns the time at which the nth recorded call was made, counting
+// from zero. It panics if fewer than n+1 calls have been recorded.
+func (s *Spy) CallTime(n int) time.Time {
+	return s.call(n, "CallTime").time
+}
+
+func (s *Spy) call(n int, caller string) spyCall {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if n < 0 || n >= len(s.calls) {
+		panic(fmt.Sprintf("%s: no call %d; %d recorded.", caller, n, len(s.calls)))
+	}
+
+	return s.calls[n]
+}
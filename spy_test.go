@@ -0,0 +1,53 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestSpy(t *testing.T) {
+	spy := NewSpy()
+	add := spy.Wrap(func(a, b int) int { return a + b }).(func(int, int) int)
+
+	expectEqInt(t, 0, spy.CallCount())
+	expectEqInt(t, 5, add(2, 3))
+	expectEqInt(t, 11, add(4, 7))
+	expectEqInt(t, 2, spy.CallCount())
+
+	args := spy.CallArgs(1)
+	if !reflect.DeepEqual(args, []interface{}{4, 7}) {
+		t.Errorf("Unexpected args: %v", args)
+	}
+
+	if spy.CallTime(1).Before(spy.CallTime(0)) {
+		t.Errorf("Calls recorded out of order.")
+	}
+}
+
+func TestSpyVariadic(t *testing.T) {
+	spy := NewSpy()
+	sprint := spy.Wrap(fmt.Sprint).(func(...interface{}) string)
+
+	expectEqStr(t, "taco 17", sprint("taco ", 17))
+
+	args := spy.CallArgs(0)
+	if !reflect.DeepEqual(args, []interface{}{[]interface{}{"taco ", 17}}) {
+		t.Errorf("Unexpected args: %v", args)
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// Succeed returns a matcher that matches anything other than a non-nil error.
+// It reads better than IsNil when checking a function's error result.
+//
+// For example:
+//
+//     ExpectThat(f.Close(), Succeed())
+//
+func Succeed() oglematchers.Matcher {
+	return &succeedMatcher{}
+}
+
+type succeedMatcher struct{}
+
+func (m *succeedMatcher) Description() string {
+	return "succeeds"
+}
+
+func (m *succeedMatcher) Matches(c interface{}) error {
+	if err, ok := c.(error); ok && err != nil {
+		return errors.New("which is a non-nil error")
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSucceed(t *testing.T) {
+	m := Succeed()
+	expectEqStr(t, "succeeds", m.Description())
+
+	for _, c := range []interface{}{nil, error(nil), 17, "taco"} {
+		if err := m.Matches(c); err != nil {
+			t.Errorf("Unexpected error for %v: %v", c, err)
+		}
+	}
+
+	setUpCurrentTest()
+	ExpectThat(errors.New("taco"), m)
+
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+	expectEqStr(
+		t,
+		"Expected: succeeds\nActual:   taco, which is a non-nil error",
+		currentlyRunningTest.failureRecords[0].Error)
+}
@@ -0,0 +1,277 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+)
+
+// TestSuiteRunner runs a single test suite on demand, reporting failures to
+// the supplied testing.T. It allows other test frameworks to delegate to
+// ogletest's suite execution logic without going through Register and
+// RunTests.
+type TestSuiteRunner interface {
+	// Run the supplied suite, which may be a TestSuite or a pointer to a test
+	// suite struct of the sort accepted by RegisterTestSuite.
+	RunSuite(suite interface{}, t *testing.T)
+}
+
+// NewTestSuiteRunner returns a TestSuiteRunner that runs suites the same way
+// RunTests does, honoring the ogletest flags. Global set-up and tear-down
+// functions are not run, and the suite need not (and generally should not) be
+// registered.
+//
+// For example:
+//
+//     func TestFoo(t *testing.T) {
+//       ogletest.NewTestSuiteRunner().RunSuite(&FooTest{}, t)
+//     }
+//
+func NewTestSuiteRunner() TestSuiteRunner {
+	return suiteRunner{}
+}
+
+type suiteRunner struct{}
+
+// Convert the supplied TestSuite, pointer to TestSuite, or pointer to a test
+// suite struct into a TestSuite, checking that it is well-formed.
+func toTestSuite(suite interface{}) (s TestSuite) {
+	switch x := suite.(type) {
+	case TestSuite:
+		s = x
+
+	case *TestSuite:
+		s = *x
+
+	default:
+		s = makeTestSuite(suite)
+	}
+
+	checkTestSuite(s)
+	return
+}
+
+func (r suiteRunner) RunSuite(suite interface{}, t *testing.T) {
+	if suite == nil {
+		panic("RunSuite called with nil suite.")
+	}
+
+	s := toTestSuite(suite)
+	checkFlags()
+	filter := flagTestFilter()
+	resetStats()
+
+	// If any tests have been focused, we will run only those.
+	focused := *fFocus && hasFocusedTests(s)
+	if focused {
+		printBanner(
+			"%s Skipping non-focused tests: focused tests are present\n",
+			Colorize("[----------]", colorGreen))
+	}
+
+	if runSuite(t, s, focused, filter, *fTimeout) {
+		printSummary()
+		fmt.Println("Exiting early due to user request.")
+		os.Exit(1)
+	}
+
+	printSummary()
+}
+
+// RunTest runs the single named test method of the supplied test suite struct
+// pointer, reporting failures to the supplied testing.T. The suite's
+// suite-level set-up and tear-down methods are run around the test, and
+// its SetUp and TearDown methods are run on the supplied instance itself, so
+// the caller may inspect its state afterward. This is convenient for invoking
+// one test from a debugger or an IDE.
+//
+// The method is run even if it would otherwise be skipped because it doesn't
+// match --ogletest.run, because other tests are focused, or because its name
+// marks it as pending.
+//
+// For example:
+//
+//     func TestFoo_DoesBar(t *testing.T) {
+//       ogletest.RunTest(t, &FooTest{}, "DoesBar")
+//     }
+//
+func RunTest(t *testing.T, suite interface{}, methodName string) {
+	if suite == nil {
+		panic("RunTest called with nil suite.")
+	}
+
+	s := makeTestSuite(suite)
+	checkTestSuite(s)
+
+	typ := reflect.TypeOf(suite)
+	method, ok := typ.MethodByName(methodName)
+	if !ok ||
+		isSpecialMethod(methodName) ||
+		(typ.Implements(subjectHolderType) && isSubjectMethod(methodName)) {
+		panic(fmt.Sprintf("RunTest: no test method %s.%s", s.Name, methodName))
+	}
+
+	s.TestFunctions = []TestFunction{
+		makeTestFunction(
+			reflect.ValueOf(suite),
+			method,
+			typ.Implements(subjectHolderType)),
+	}
+
+	checkFlags()
+	resetStats()
+
+	if runSuite(t, s, false, nil, *fTimeout) {
+		printSummary()
+		fmt.Println("Exiting early due to user request.")
+		os.Exit(1)
+	}
+
+	printSummary()
+}
+
+// SuiteRunner runs test suites with configuration supplied in code rather
+// than on the command line, for embedding ogletest in custom test runners.
+// Settings not covered by a RunnerOption are still taken from the ogletest
+// flags.
+type SuiteRunner struct {
+	// Set by options, or nil to use the corresponding flag.
+	filter  *string
+	timeout *time.Duration
+
+	formatter Formatter
+}
+
+// A RunnerOption configures a SuiteRunner created with NewSuiteRunner.
+type RunnerOption func(*SuiteRunner)
+
+// WithFilter causes the runner to run only tests whose full names (e.g.
+// "FooTest.DoesBar") match the supplied regexp, like --ogletest.run.
+func WithFilter(pattern string) RunnerOption {
+	return func(r *SuiteRunner) { r.filter = &pattern }
+}
+
+// WithTimeout causes the runner to cancel each test's context after the
+// supplied duration, like --ogletest.timeout. Zero means no timeout.
+func WithTimeout(d time.Duration) RunnerOption {
+	return func(r *SuiteRunner) { r.timeout = &d }
+}
+
+// WithFormatter causes the runner to report test results to the supplied
+// Formatter and to print no progress output or summary of its own, overriding
+// --ogletest.dots and --ogletest.summary-only.
+func WithFormatter(f Formatter) RunnerOption {
+	return func(r *SuiteRunner) { r.formatter = f }
+}
+
+// NewSuiteRunner creates a SuiteRunner configured by the supplied options.
+// Options that are not supplied default to the values of the corresponding
+// flags at the time Run is called.
+//
+// For example:
+//
+//     func TestFoo(t *testing.T) {
+//       r := ogletest.NewSuiteRunner(
+//         ogletest.WithFilter("Bar"),
+//         ogletest.WithTimeout(time.Second))
+//
+//       r.Run(t, &FooTest{}, &BazTest{})
+//     }
+//
+func NewSuiteRunner(opts ...RunnerOption) *SuiteRunner {
+	r := &SuiteRunner{}
+
+	for _, o := range opts {
+		o(r)
+	}
+
+	return r
+}
+
+// Run the supplied suites in order, reporting failures to t. Each suite may
+// be a TestSuite or a pointer to a test suite struct of the sort accepted by
+// RegisterTestSuite. As with TestSuiteRunner, global set-up and tear-down
+// functions are not run.
+//
+// Run must not be called concurrently with any other ogletest run.
+func (r *SuiteRunner) Run(t *testing.T, suites ...interface{}) {
+	pattern := *fTestFilter
+	if r.filter != nil {
+		pattern = *r.filter
+	}
+
+	filter, err := regexp.Compile(pattern)
+	if err != nil {
+		panic("Invalid filter for SuiteRunner: " + err.Error())
+	}
+
+	timeout := *fTimeout
+	if r.timeout != nil {
+		timeout = *r.timeout
+	}
+
+	var ss []TestSuite
+	for _, suite := range suites {
+		if suite == nil {
+			panic("SuiteRunner.Run called with nil suite.")
+		}
+
+		ss = append(ss, toTestSuite(suite))
+	}
+
+	checkFlags()
+	resetStats()
+	gStats.formatter = r.formatter
+
+	// If any tests have been focused, we will run only those.
+	focused := false
+	for _, s := range ss {
+		focused = focused || (*fFocus && hasFocusedTests(s))
+	}
+
+	if focused {
+		printBanner(
+			"%s Skipping non-focused tests: focused tests are present\n",
+			Colorize("[----------]", colorGreen))
+	}
+
+	for _, s := range ss {
+		// Stop now if we've already seen a failure and we've been told to stop
+		// early.
+		if t.Failed() && *fStopEarly {
+			break
+		}
+
+		// Likewise if we've seen as many failures as we've been told to
+		// tolerate.
+		if stopForMaxFailures() {
+			break
+		}
+
+		if runSuite(t, s, focused, filter, timeout) {
+			printSummary()
+			fmt.Println("Exiting early due to user request.")
+			os.Exit(1)
+		}
+	}
+
+	printSummary()
+}
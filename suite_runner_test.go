@@ -0,0 +1,81 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"strings"
+	"testing"
+)
+
+type recordingFormatter struct {
+	results  []string
+	finished bool
+}
+
+func (f *recordingFormatter) TestFinished(
+	name string,
+	r Result,
+	failures []FailureRecord) {
+	f.results = append(f.results, name+" "+r.String())
+}
+
+func (f *recordingFormatter) RunFinished() {
+	f.finished = true
+}
+
+func TestSuiteRunnerWithFormatter(t *testing.T) {
+	suite := TestSuite{
+		Name: "FooTest",
+		TestFunctions: []TestFunction{
+			{Name: "DoesFoo", Run: func() {}},
+			{Name: "PendingBar", Run: func() {}},
+		},
+	}
+
+	f := &recordingFormatter{}
+	stdout, _ := CaptureOutput(func() {
+		NewSuiteRunner(WithFormatter(f)).Run(t, suite)
+	})
+
+	expectEqStr(t, "", stdout)
+	expectEqStr(
+		t,
+		"FooTest.DoesFoo passed\nFooTest.PendingBar pending",
+		strings.Join(f.results, "\n"))
+
+	if !f.finished {
+		t.Error("RunFinished was not called.")
+	}
+}
+
+func TestSuiteRunnerReadsFlagsWhenRun(t *testing.T) {
+	var ran []string
+	suite := TestSuite{
+		Name: "FooTest",
+		TestFunctions: []TestFunction{
+			{Name: "DoesFoo", Run: func() { ran = append(ran, "DoesFoo") }},
+			{Name: "DoesBar", Run: func() { ran = append(ran, "DoesBar") }},
+		},
+	}
+
+	r := NewSuiteRunner()
+
+	*fTestFilter = "Bar"
+	defer func() { *fTestFilter = "" }()
+
+	CaptureOutput(func() { r.Run(t, suite) })
+	expectEqStr(t, "DoesBar", strings.Join(ran, ","))
+}
@@ -0,0 +1,69 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+// Neither suite is registered; they are run directly here.
+func TestCustomRunner(t *testing.T) {
+	r := NewSuiteRunner(
+		WithFilter("Timeout|Failing"),
+		WithTimeout(time.Hour))
+
+	r.Run(t, &FirstRunnerTest{}, &SecondRunnerTest{})
+}
+
+////////////////////////////////////////////////////////////////////////
+// FirstRunnerTest
+////////////////////////////////////////////////////////////////////////
+
+type FirstRunnerTest struct {
+	ti *TestInfo
+}
+
+func (t *FirstRunnerTest) SetUp(ti *TestInfo) {
+	t.ti = ti
+}
+
+func (t *FirstRunnerTest) HasTimeout() {
+	_, ok := t.ti.Ctx.Deadline()
+	ExpectTrue(ok)
+}
+
+func (t *FirstRunnerTest) FilteredOut() {
+	AddFailure("Should not be run.")
+}
+
+////////////////////////////////////////////////////////////////////////
+// SecondRunnerTest
+////////////////////////////////////////////////////////////////////////
+
+type SecondRunnerTest struct {
+}
+
+func (t *SecondRunnerTest) FailingTest() {
+	ExpectThat(17, Equals(19))
+}
+
+func (t *SecondRunnerTest) AlsoFilteredOut() {
+	AddFailure("Should not be run.")
+}
@@ -0,0 +1,57 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+	"testing"
+)
+
+func TestDots(t *testing.T) { RunTests(t) }
+
+////////////////////////////////////////////////////////////////////////
+// DotsTest
+////////////////////////////////////////////////////////////////////////
+
+type DotsTest struct {
+}
+
+func init() { RegisterTestSuite(&DotsTest{}) }
+
+func (t *DotsTest) PassingTest() {
+	ExpectThat(17, Equals(17))
+}
+
+func (t *DotsTest) FailingTest() {
+	ExpectThat(17, Equals(19))
+}
+
+func (t *DotsTest) PendingTest() {
+}
+
+////////////////////////////////////////////////////////////////////////
+// MoreDotsTest
+////////////////////////////////////////////////////////////////////////
+
+type MoreDotsTest struct {
+}
+
+func init() { RegisterTestSuite(&MoreDotsTest{}) }
+
+func (t *MoreDotsTest) PassingTest() {
+	ExpectThat("taco", HasSubstr("ac"))
+}
@@ -0,0 +1,58 @@
+// Copyright 2012 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+	"testing"
+)
+
+func TestFocused(t *testing.T) { RunTests(t) }
+
+////////////////////////////////////////////////////////////////////////
+// FocusedTest
+////////////////////////////////////////////////////////////////////////
+
+type FocusedTest struct {
+}
+
+func init() { RegisterTestSuite(&FocusedTest{}) }
+
+func (t *FocusedTest) FailingTest() {
+	ExpectThat(17, Equals(19))
+}
+
+func (t *FocusedTest) FocusPassingTest() {
+	ExpectThat(17, Equals(17))
+}
+
+func (t *FocusedTest) FocusesAreNotFocused() {
+	ExpectThat(17, Equals(19))
+}
+
+////////////////////////////////////////////////////////////////////////
+// UnfocusedTest
+////////////////////////////////////////////////////////////////////////
+
+type UnfocusedTest struct {
+}
+
+func init() { RegisterTestSuite(&UnfocusedTest{}) }
+
+func (t *UnfocusedTest) FailingTest() {
+	ExpectThat(17, Equals(19))
+}
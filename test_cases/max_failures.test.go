@@ -0,0 +1,62 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+	"testing"
+)
+
+func TestMaxFailures(t *testing.T) { RunTests(t) }
+
+////////////////////////////////////////////////////////////////////////
+// MaxFailuresTest
+////////////////////////////////////////////////////////////////////////
+
+type MaxFailuresTest struct {
+}
+
+func init() { RegisterTestSuite(&MaxFailuresTest{}) }
+
+func (t *MaxFailuresTest) FirstFailingTest() {
+	ExpectThat(17, Equals(19))
+}
+
+func (t *MaxFailuresTest) PassingTest() {
+	ExpectThat(17, Equals(17))
+}
+
+func (t *MaxFailuresTest) SecondFailingTest() {
+	ExpectThat(17, Equals(23))
+}
+
+func (t *MaxFailuresTest) NeverRunTest() {
+	ExpectThat(17, Equals(29))
+}
+
+////////////////////////////////////////////////////////////////////////
+// NeverRunTest
+////////////////////////////////////////////////////////////////////////
+
+type NeverRunTest struct {
+}
+
+func init() { RegisterTestSuite(&NeverRunTest{}) }
+
+func (t *NeverRunTest) FailingTest() {
+	ExpectThat(17, Equals(31))
+}
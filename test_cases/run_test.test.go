@@ -0,0 +1,69 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+	"testing"
+)
+
+// The suite is not registered; its methods are run one at a time here.
+func TestRunTest(t *testing.T) {
+	s := &RunTestTest{}
+	RunTest(t, s, "PassingTest")
+	RunTest(t, s, "FailingTest")
+
+	// Methods that would otherwise be skipped are run when asked for by name.
+	RunTest(t, s, "PendingButRunAnyway")
+
+	// SetUp and TearDown ran on the instance we supplied.
+	if s.counter != 18 {
+		t.Errorf("Unexpected counter: %d", s.counter)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////
+// RunTestTest
+////////////////////////////////////////////////////////////////////////
+
+type RunTestTest struct {
+	counter int
+}
+
+func (t *RunTestTest) SetUp(ti *TestInfo) {
+	t.counter = 17
+}
+
+func (t *RunTestTest) TearDown() {
+	t.counter++
+}
+
+func (t *RunTestTest) PassingTest() {
+	ExpectThat(t.counter, Equals(17))
+}
+
+func (t *RunTestTest) FailingTest() {
+	ExpectThat(t.counter, Equals(19))
+}
+
+func (t *RunTestTest) NeverRunTest() {
+	ExpectThat(t.counter, Equals(23))
+}
+
+func (t *RunTestTest) PendingButRunAnyway() {
+	ExpectThat(t.counter, Equals(17))
+}
@@ -0,0 +1,63 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+	"testing"
+)
+
+// Neither suite is registered; they are run directly here.
+func TestRunSuite(t *testing.T) {
+	runner := NewTestSuiteRunner()
+	runner.RunSuite(&StructSuiteTest{}, t)
+	runner.RunSuite(handWrittenSuite, t)
+}
+
+////////////////////////////////////////////////////////////////////////
+// StructSuiteTest
+////////////////////////////////////////////////////////////////////////
+
+type StructSuiteTest struct {
+	counter int
+}
+
+func (t *StructSuiteTest) SetUp(ti *TestInfo) {
+	t.counter = 17
+}
+
+func (t *StructSuiteTest) PassingTest() {
+	ExpectThat(t.counter, Equals(17))
+}
+
+func (t *StructSuiteTest) FailingTest() {
+	ExpectThat(t.counter, Equals(19))
+}
+
+////////////////////////////////////////////////////////////////////////
+// HandWrittenSuite
+////////////////////////////////////////////////////////////////////////
+
+var handWrittenSuite = TestSuite{
+	Name: "HandWrittenSuite",
+	TestFunctions: []TestFunction{
+		{
+			Name: "PassingTest",
+			Run:  func() { ExpectThat("taco", HasSubstr("ac")) },
+		},
+	},
+}
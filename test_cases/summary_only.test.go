@@ -0,0 +1,57 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+	"testing"
+)
+
+func TestSummaryOnly(t *testing.T) { RunTests(t) }
+
+////////////////////////////////////////////////////////////////////////
+// SummaryOnlyTest
+////////////////////////////////////////////////////////////////////////
+
+type SummaryOnlyTest struct {
+}
+
+func init() { RegisterTestSuite(&SummaryOnlyTest{}) }
+
+func (t *SummaryOnlyTest) PassingTest() {
+	ExpectThat(17, Equals(17))
+}
+
+func (t *SummaryOnlyTest) FailingTest() {
+	ExpectThat(17, Equals(19))
+}
+
+func (t *SummaryOnlyTest) PendingTest() {
+}
+
+////////////////////////////////////////////////////////////////////////
+// MoreSummaryOnlyTest
+////////////////////////////////////////////////////////////////////////
+
+type MoreSummaryOnlyTest struct {
+}
+
+func init() { RegisterTestSuite(&MoreSummaryOnlyTest{}) }
+
+func (t *MoreSummaryOnlyTest) PassingTest() {
+	ExpectThat("taco", HasSubstr("ac"))
+}
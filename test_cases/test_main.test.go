@@ -0,0 +1,53 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+// BeforeSuite and AfterSuite functions surround the whole test binary, while
+// global set-up and tear-down functions surround only RunTests.
+func init() {
+	BeforeSuite(func() { fmt.Println("BeforeSuite 1") })
+	BeforeSuite(func() { fmt.Println("BeforeSuite 2") })
+	AfterSuite(func() { fmt.Println("AfterSuite 1") })
+	AfterSuite(func() { fmt.Println("AfterSuite 2") })
+
+	RegisterGlobalSetUp(func() { fmt.Println("RegisterGlobalSetUp") })
+	RegisterGlobalTearDown(func() { fmt.Println("RegisterGlobalTearDown") })
+}
+
+func TestMain(m *testing.M) { Main(m) }
+
+func TestOgletest(t *testing.T) { RunTests(t) }
+
+////////////////////////////////////////////////////////////////////////
+// MainTest
+////////////////////////////////////////////////////////////////////////
+
+type MainTest struct {
+}
+
+func init() { RegisterTestSuite(&MainTest{}) }
+
+func (t *MainTest) PassingTest() {
+	ExpectThat(17, Equals(17))
+}
@@ -0,0 +1,58 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"runtime"
+)
+
+// TestData returns the contents of the named file within the testdata
+// directory alongside the calling test's source file, halting the test with a
+// failure if it can't be read. The name may contain slashes to refer to files
+// in subdirectories.
+//
+// For example:
+//
+//     config := TestData("configs/minimal.json")
+//
+func TestData(name string) []byte {
+	if currentlyRunningTest == nil {
+		panic("TestData: no test info.")
+	}
+
+	contents, err := ioutil.ReadFile(testDataPath(1, name))
+	if err != nil {
+		recordFailure(1, fmt.Sprintf("TestData: %v", err), nil)
+		AbortTest()
+	}
+
+	return contents
+}
+
+// Return the path to the named file within the testdata directory alongside
+// the source file of the user's frame, which is depth frames above the caller
+// of this function.
+func testDataPath(depth int, name string) string {
+	_, file, _, ok := runtime.Caller(depth + 1)
+	if !ok {
+		panic("testDataPath: runtime.Caller")
+	}
+
+	return filepath.Join(filepath.Dir(file), "testdata", filepath.FromSlash(name))
+}
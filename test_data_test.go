@@ -0,0 +1,53 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestTestDataPath(t *testing.T) {
+	_, file, _, _ := runtime.Caller(0)
+	expectEqStr(
+		t,
+		filepath.Join(filepath.Dir(file), "testdata", "foo", "bar.json"),
+		testDataPath(0, "foo/bar.json"))
+}
+
+func TestTestDataMissingFile(t *testing.T) {
+	setUpCurrentTest()
+
+	aborted := false
+	func() {
+		defer func() { aborted = isAbortError(recover()) }()
+		TestData("no_such_file")
+	}()
+
+	if !aborted {
+		t.Errorf("Expected the test to be aborted.")
+	}
+
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+	record := currentlyRunningTest.failureRecords[0]
+
+	expectEqStr(t, "test_data_test.go", record.FileName)
+	if !strings.HasPrefix(record.Error, "TestData: ") {
+		t.Errorf("Unexpected error: %s", record.Error)
+	}
+}
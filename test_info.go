@@ -16,7 +16,9 @@
 package ogletest
 
 import (
+	"fmt"
 	"sync"
+	"testing"
 
 	"golang.org/x/net/context"
 
@@ -37,8 +39,15 @@ type TestInfo struct {
 	// A context that can be used by tests for long-running operations. In
 	// particular, this enables conveniently tracing the execution of a test
 	// function with reqtrace.
+	//
+	// The context is cancelled when the test finishes, or once the duration
+	// given by --ogletest.timeout has elapsed if that flag is set.
 	Ctx context.Context
 
+	// The testing.T object given to RunTests, through which the test's failures
+	// will eventually be reported.
+	t *testing.T
+
 	// A mutex protecting shared state.
 	mu sync.RWMutex
 
@@ -46,40 +55,192 @@ type TestInfo struct {
 	//
 	// GUARDED_BY(mu)
 	failureRecords []FailureRecord
+
+	// Functions to be run after the test's TearDown method, in reverse order of
+	// registration.
+	//
+	// GUARDED_BY(mu)
+	cleanups []func()
+
+	// Timings recorded by calls to Measure.
+	//
+	// GUARDED_BY(mu)
+	measurements []measurement
+
+	// The wrapper around the original value of MockController that records the
+	// calls it handles.
+	mockCalls *recordingController
+
+	// The sequence ID most recently assigned to a mock call made by the test.
+	// Accessed atomically.
+	mockCallSeq uint64
 }
 
 // currentlyRunningTest is the state for the currently running test, if any.
 var currentlyRunningTest *TestInfo
 
+// CurrentTest returns the TestInfo struct for the currently running test.
+func CurrentTest() *TestInfo {
+	if currentlyRunningTest == nil {
+		panic("CurrentTest: no test info.")
+	}
+
+	return currentlyRunningTest
+}
+
+// CurrentContext returns the context for the currently running test. It is
+// equivalent to CurrentTest().Ctx.
+func CurrentContext() context.Context {
+	if currentlyRunningTest == nil {
+		panic("CurrentContext: no test info.")
+	}
+
+	return currentlyRunningTest.Ctx
+}
+
+// T returns the *testing.T object associated with the currently running test.
+// This is an escape hatch for use with third-party helpers that require one;
+// most tests should not need it.
+//
+// Note that the object is shared by all tests run by a single call to
+// RunTests, so methods like FailNow and SkipNow affect all of them.
+func T() *testing.T {
+	if currentlyRunningTest == nil {
+		panic("T: no test info.")
+	}
+
+	return currentlyRunningTest.t
+}
+
+// Defer arranges for f to be called once the currently running test has
+// finished, after its TearDown method has run. Functions registered this way
+// run in last-in, first-out order, like deferred calls. Unlike a defer
+// statement, this may be called from a helper function to register cleanup
+// that outlives the helper.
+//
+// For example:
+//
+//     func createTempDir() string {
+//       dir, err := ioutil.TempDir("", "foo_test")
+//       AssertEq(nil, err)
+//       Defer(func() { os.RemoveAll(dir) })
+//       return dir
+//     }
+//
+func Defer(f func()) {
+	if currentlyRunningTest == nil {
+		panic("Defer: no test info.")
+	}
+
+	currentlyRunningTest.addCleanup(f)
+}
+
 // newTestInfo creates a valid but empty TestInfo struct.
 func newTestInfo() (info *TestInfo) {
 	info = &TestInfo{}
-	info.MockController = oglemock.NewController(&testInfoErrorReporter{info})
+	info.mockCalls = newMockController(info)
+	info.MockController = info.mockCalls
 	info.Ctx = context.Background()
 
 	return
 }
 
+// Add a failure record to the test. Unless the user has disabled it with
+// --ogletest.no-dedup, a record identical to one already present is folded
+// into that record rather than added again. If the user has asked for
+// --ogletest.panic-on-failure, panic with the record once it has been added.
+//
+// EXCLUSIVE_LOCKS_REQUIRED(info.mu)
+func (info *TestInfo) addFailureRecord(r FailureRecord) {
+	if !*fNoDedup {
+		for i := range info.failureRecords {
+			existing := &info.failureRecords[i]
+			if existing.FileName == r.FileName &&
+				existing.LineNumber == r.LineNumber &&
+				existing.Error == r.Error {
+				existing.repeats++
+				return
+			}
+		}
+	}
+
+	info.failureRecords = append(info.failureRecords, r)
+
+	if *fPanicOnFailure {
+		panic(failurePanic{r})
+	}
+}
+
+// Register a function to be run once the test has finished.
+func (info *TestInfo) addCleanup(f func()) {
+	info.mu.Lock()
+	defer info.mu.Unlock()
+
+	info.cleanups = append(info.cleanups, f)
+}
+
+// Run registered cleanup functions, most recently registered first. A panic in
+// one doesn't prevent the others from running.
+func (info *TestInfo) runCleanups() {
+	for {
+		info.mu.Lock()
+		n := len(info.cleanups)
+		if n == 0 {
+			info.mu.Unlock()
+			return
+		}
+
+		f := info.cleanups[n-1]
+		info.cleanups = info.cleanups[:n-1]
+		info.mu.Unlock()
+
+		runWithProtection(f)
+	}
+}
+
+// Create a mock controller that reports errors to the supplied test, tagged
+// with the sequence ID of the offending call.
+func newMockController(info *TestInfo) *recordingController {
+	reporter := &testInfoErrorReporter{testInfo: info}
+	c := newRecordingController(
+		oglemock.NewController(reporter),
+		&info.mockCallSeq)
+
+	reporter.calls = c
+	return c
+}
+
 // testInfoErrorReporter is an oglemock.ErrorReporter that writes failure
 // records into a test info struct.
 type testInfoErrorReporter struct {
 	testInfo *TestInfo
+
+	// The controller whose errors are being reported, used to find the
+	// sequence ID of the call being handled when an error is reported.
+	calls *recordingController
 }
 
 func (r *testInfoErrorReporter) ReportError(
 	fileName string,
 	lineNumber int,
 	err error) {
+	msg := err.Error()
+	if r.calls != nil {
+		if seq := r.calls.currentCall(); seq != 0 {
+			msg = fmt.Sprintf("Mock call #%d: %s", seq, msg)
+		}
+	}
+
 	r.testInfo.mu.Lock()
 	defer r.testInfo.mu.Unlock()
 
 	record := FailureRecord{
 		FileName:   fileName,
 		LineNumber: lineNumber,
-		Error:      err.Error(),
+		Error:      msg,
 	}
 
-	r.testInfo.failureRecords = append(r.testInfo.failureRecords, record)
+	r.testInfo.addFailureRecord(record)
 }
 
 func (r *testInfoErrorReporter) ReportFatalError(
@@ -0,0 +1,127 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestDeferRunsInReverseOrder(t *testing.T) {
+	setUpCurrentTest()
+
+	var order []int
+	Defer(func() { order = append(order, 1) })
+	Defer(func() { order = append(order, 2) })
+	currentlyRunningTest.runCleanups()
+
+	assertEqInt(t, 2, len(order))
+	expectEqInt(t, 2, order[0])
+	expectEqInt(t, 1, order[1])
+}
+
+func TestPanicOnFailure(t *testing.T) {
+	setUpCurrentTest()
+
+	*fPanicOnFailure = true
+	defer func() { *fPanicOnFailure = false }()
+
+	// The panic should escape the usual protection around test functions.
+	var r interface{}
+	func() {
+		defer func() { r = recover() }()
+		runWithProtection(func() { AddFailure("taco") })
+	}()
+
+	p, ok := r.(failurePanic)
+	if !ok {
+		t.Fatalf("Expected a failurePanic, got %v", r)
+	}
+
+	expectEqStr(t, "taco", p.Record.Error)
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+}
+
+func TestContextCancelledWhenTestEnds(t *testing.T) {
+	var ctx context.Context
+	tf := TestFunction{
+		Name: "DoesFoo",
+		Run: func() {
+			ctx = CurrentContext()
+			if ctx.Err() != nil {
+				panic("Context cancelled early.")
+			}
+		},
+	}
+
+	failures := runTestFunction(t, "FooTest.DoesFoo", tf, 0)
+	assertEqInt(t, 0, len(failures))
+
+	if ctx.Err() != context.Canceled {
+		t.Errorf("Unexpected context error: %v", ctx.Err())
+	}
+}
+
+func TestContextCancelledOnTimeout(t *testing.T) {
+	var err error
+	tf := TestFunction{
+		Name: "DoesFoo",
+		Run: func() {
+			ctx := CurrentContext()
+			select {
+			case <-ctx.Done():
+				err = ctx.Err()
+
+			case <-time.After(10 * time.Second):
+			}
+		},
+	}
+
+	*fTimeout = time.Millisecond
+	defer func() { *fTimeout = 0 }()
+
+	suite := TestSuite{Name: "FooTest", TestFunctions: []TestFunction{tf}}
+	CaptureOutput(func() { NewTestSuiteRunner().RunSuite(suite, t) })
+
+	if err != context.DeadlineExceeded {
+		t.Errorf("Unexpected context error: %v", err)
+	}
+}
+
+func TestT(t *testing.T) {
+	var got *testing.T
+	tf := TestFunction{
+		Name: "DoesFoo",
+		Run:  func() { got = T() },
+	}
+
+	runTestFunction(t, "FooTest.DoesFoo", tf, 0)
+	if got != t {
+		t.Errorf("T returned %p, expected %p", got, t)
+	}
+
+	// Outside of a test there is nothing to return.
+	currentlyRunningTest = nil
+	defer func() {
+		r := recover()
+		if r != "T: no test info." {
+			t.Errorf("Unexpected panic: %v", r)
+		}
+	}()
+
+	T()
+}
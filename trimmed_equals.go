@@ -0,0 +1,53 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// TrimmedEquals returns a matcher that matches strings equal to expected once
+// leading and trailing whitespace has been removed from both, as with
+// strings.TrimSpace. Descriptions quote the untrimmed values so that stray
+// whitespace is visible in failure messages.
+func TrimmedEquals(expected string) oglematchers.Matcher {
+	return &trimmedEqualsMatcher{expected}
+}
+
+type trimmedEqualsMatcher struct {
+	expected string
+}
+
+func (m *trimmedEqualsMatcher) Description() string {
+	return fmt.Sprintf("equal after trimming whitespace to %q", m.expected)
+}
+
+func (m *trimmedEqualsMatcher) Matches(c interface{}) error {
+	s, ok := c.(string)
+	if !ok {
+		return oglematchers.NewFatalError("which is not a string")
+	}
+
+	if strings.TrimSpace(s) == strings.TrimSpace(m.expected) {
+		return nil
+	}
+
+	return errors.New(fmt.Sprintf("which is %q", s))
+}
@@ -0,0 +1,34 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import "testing"
+
+func TestTrimmedEquals(t *testing.T) {
+	m := TrimmedEquals("taco\n")
+	expectEqStr(t, `equal after trimming whitespace to "taco\n"`, m.Description())
+
+	if err := m.Matches("  taco"); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	err := m.Matches(" burrito\n")
+	if err == nil {
+		t.Fatalf("Expected an error.")
+	}
+
+	expectEqStr(t, `which is " burrito\n"`, err.Error())
+}
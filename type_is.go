@@ -0,0 +1,57 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// TypeIs returns a matcher that matches values that can be type-asserted to
+// T: values of type T itself when T is a concrete type, or values
+// implementing T when it is an interface. Because the type is given as a type
+// parameter, mistakes in naming it are caught at compile time.
+//
+// For example:
+//
+//     ExpectThat(err, TypeIs[*os.PathError]())
+//     ExpectThat(w, TypeIs[io.Closer]())
+//
+func TypeIs[T any]() oglematchers.Matcher {
+	return &typeIsMatcher[T]{}
+}
+
+type typeIsMatcher[T any] struct {
+}
+
+func (m *typeIsMatcher[T]) Description() string {
+	return fmt.Sprintf("of type %v", reflect.TypeOf((*T)(nil)).Elem())
+}
+
+func (m *typeIsMatcher[T]) Matches(c interface{}) error {
+	if c == nil {
+		return oglematchers.NewFatalError("which is nil")
+	}
+
+	if _, ok := c.(T); ok {
+		return nil
+	}
+
+	return errors.New(fmt.Sprintf("which has type %T", c))
+}
@@ -0,0 +1,41 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTypeIs(t *testing.T) {
+	m := TypeIs[fmt.Stringer]()
+	expectEqStr(t, "of type fmt.Stringer", m.Description())
+
+	if err := m.Matches(&fakeMockObject{}); err == nil {
+		t.Errorf("Expected an error.")
+	}
+
+	if err := TypeIs[int]().Matches(17); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	err := TypeIs[int]().Matches("taco")
+	if err == nil {
+		t.Fatalf("Expected an error.")
+	}
+
+	expectEqStr(t, "which has type string", err.Error())
+}
@@ -0,0 +1,47 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import "github.com/jacobsa/oglematchers"
+
+// WithDescription returns a matcher that matches exactly the values matched
+// by m, but whose description is desc. This is useful for replacing the
+// descriptions of complicated compositions of matchers, which can be hard to
+// read in failure messages.
+//
+// For example:
+//
+//     isVowel := WithDescription(AnyOf("a", "e", "i", "o", "u"), "vowel")
+//     ExpectThat(c, isVowel)
+//
+func WithDescription(
+	m oglematchers.Matcher,
+	desc string) oglematchers.Matcher {
+	return &withDescriptionMatcher{m, desc}
+}
+
+type withDescriptionMatcher struct {
+	wrapped oglematchers.Matcher
+	desc    string
+}
+
+func (m *withDescriptionMatcher) Description() string {
+	return m.desc
+}
+
+func (m *withDescriptionMatcher) Matches(c interface{}) error {
+	return m.wrapped.Matches(c)
+}
@@ -0,0 +1,47 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWithDescription(t *testing.T) {
+	wrapped := &fakeExpectThatMatcher{"taco", nil}
+	m := WithDescription(wrapped, "burrito")
+	expectEqStr(t, "burrito", m.Description())
+
+	// Matches delegates to the wrapped matcher, passing its result through.
+	if err := m.Matches(17); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	wrapped.err = errors.New("which is not delicious")
+	if err := m.Matches(17); err != wrapped.err {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	// The failure message uses the new description.
+	setUpCurrentTest()
+	ExpectThat(17, m)
+
+	assertEqInt(t, 1, len(currentlyRunningTest.failureRecords))
+	expectEqStr(
+		t,
+		"Expected: burrito\nActual:   17, which is not delicious",
+		currentlyRunningTest.failureRecords[0].Error)
+}
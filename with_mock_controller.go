@@ -0,0 +1,51 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import "github.com/jacobsa/oglemock"
+
+// WithMockController calls f with a fresh mock controller that reports errors
+// to the currently running test, and verifies the controller's expectations
+// when f returns. This is useful in helpers that want mock expectations
+// checked at the end of some scope, rather than at the end of the test as with
+// TestInfo.MockController.
+//
+// While f runs, the new controller takes the place of the test's
+// MockController, so ExpectCall sets up expectations on it.
+//
+// For example:
+//
+//     WithMockController(func(c oglemock.Controller) {
+//       w := mock_io.NewMockWriter(c, "w")
+//       ExpectCall(w, "Write")(Any()).WillOnce(oglemock.Return(0, nil))
+//       writeGreeting(w)
+//     })
+//
+func WithMockController(f func(c oglemock.Controller)) {
+	info := currentlyRunningTest
+	if info == nil {
+		panic("WithMockController: no test info.")
+	}
+
+	c := newMockController(info)
+	defer c.Finish()
+
+	original := info.MockController
+	info.MockController = c
+	defer func() { info.MockController = original }()
+
+	f(c)
+}
@@ -0,0 +1,52 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"testing"
+
+	"github.com/jacobsa/oglemock"
+)
+
+type finishCountingController struct {
+	oglemock.Controller
+	finished int
+}
+
+func (c *finishCountingController) Finish() {
+	c.finished++
+}
+
+func TestWithMockControllerFinishes(t *testing.T) {
+	setUpCurrentTest()
+	original := currentlyRunningTest.MockController
+
+	wrapped := &finishCountingController{}
+	WithMockController(func(c oglemock.Controller) {
+		if currentlyRunningTest.MockController != c {
+			t.Errorf("The test's MockController was not replaced.")
+		}
+
+		// Observe the calls the fresh controller forwards to oglemock.
+		c.(*recordingController).wrapped = wrapped
+		expectEqInt(t, 0, wrapped.finished)
+	})
+
+	expectEqInt(t, 1, wrapped.finished)
+	if currentlyRunningTest.MockController != original {
+		t.Errorf("The test's MockController was not restored.")
+	}
+}
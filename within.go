@@ -0,0 +1,59 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+// Within returns a matcher for time.Duration values that matches durations no
+// longer than d. It is like LessOrEqual, but describes failures in terms of
+// time taken.
+//
+// For example:
+//
+//     start := time.Now()
+//     server.Shutdown()
+//     ExpectThat(time.Since(start), Within(time.Second))
+//
+func Within(d time.Duration) oglematchers.Matcher {
+	return &withinMatcher{d}
+}
+
+type withinMatcher struct {
+	limit time.Duration
+}
+
+func (m *withinMatcher) Description() string {
+	return fmt.Sprintf("within %v", m.limit)
+}
+
+func (m *withinMatcher) Matches(c interface{}) error {
+	d, ok := c.(time.Duration)
+	if !ok {
+		return oglematchers.NewFatalError("which is not a time.Duration")
+	}
+
+	if d <= m.limit {
+		return nil
+	}
+
+	return errors.New(fmt.Sprintf("which took %v, expected ≤%v", d, m.limit))
+}
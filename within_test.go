@@ -0,0 +1,46 @@
+// Copyright 2015 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ogletest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jacobsa/oglematchers"
+)
+
+func TestWithin(t *testing.T) {
+	m := Within(time.Second)
+	expectEqStr(t, "within 1s", m.Description())
+
+	for _, d := range []time.Duration{0, time.Second} {
+		if err := m.Matches(d); err != nil {
+			t.Errorf("Unexpected error for %v: %v", d, err)
+		}
+	}
+
+	err := m.Matches(1230 * time.Millisecond)
+	if err == nil {
+		t.Fatalf("Expected an error.")
+	}
+
+	expectEqStr(t, "which took 1.23s, expected ≤1s", err.Error())
+
+	err = m.Matches(1.0)
+	if _, ok := err.(*oglematchers.FatalError); !ok {
+		t.Errorf("Expected a fatal error, got %v", err)
+	}
+}